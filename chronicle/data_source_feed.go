@@ -0,0 +1,162 @@
+package chronicle
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// feedSecretMarkers identify the settings of a feed that hold secrets, they are matched against lowercase setting names.
+var feedSecretMarkers = []string{"secret", "password", "token", "sharedkey", "privatekey", "apikey", "headerkeyvalues"}
+
+func dataSourceFeed() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFeedRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(FiveMinutesTimeout),
+		},
+
+		Description: `Reads a feed of any type, including feeds not managed by this configuration. Secrets are left out of its settings.`,
+
+		Schema: map[string]*schema.Schema{
+			"feed_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `ID of the feed.`,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Name to be displayed.`,
+			},
+			"log_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Log Type is a label which describes the nature of the data being ingested.`,
+			},
+			"feed_source_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Feed Source Type describes how data is collected.`,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `State gives some insight into the current state of a feed.`,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `Enabled specifies whether a feed is allowed to be executed.`,
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The namespace the feed is associated with.`,
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Labels applied to the events of the feed.`,
+			},
+			"details_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Source settings of the feed as returned by the API, encoded in JSON, without secrets.`,
+			},
+		},
+	}
+}
+
+func dataSourceFeedRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	feedID := readStringFromResource(d, "feed_id")
+
+	feed, conf, err := client.ReadGenericFeed(feedID)
+	if err != nil {
+		return fmt.Errorf("error reading Feed %q: %s", feedID, err)
+	}
+
+	d.SetId(feed.Name)
+
+	for attribute, value := range flattenFeedSummary(*feed) {
+		if attribute == "id" {
+			continue
+		}
+		if err := d.Set(attribute, value); err != nil {
+			return fmt.Errorf("error reading %s: %s", attribute, err)
+		}
+	}
+
+	details, err := json.Marshal(redactFeedSettings(conf.Settings))
+	if err != nil {
+		return fmt.Errorf("error encoding Details: %s", err)
+	}
+	if err := d.Set("details_json", string(details)); err != nil {
+		return fmt.Errorf("error reading Details: %s", err)
+	}
+
+	log.Printf("[DEBUG] Finished reading Feed %q", d.Id())
+
+	return nil
+}
+
+func flattenFeedSummary(feed chronicle.BaseFeed) map[string]interface{} {
+	return map[string]interface{}{
+		"id":               feed.Name,
+		"display_name":     feed.DisplayName,
+		"log_type":         feed.Details.LogType,
+		"feed_source_type": feed.Details.SourceType,
+		"state":            feed.State,
+		"enabled":          feed.State != FeedStateInactive,
+		"namespace":        feed.Details.Namespace,
+		"labels":           extractLabelMapFromFeedLabels(feed.Details.Labels),
+	}
+}
+
+// redactFeedSettings drops the secrets from settings. Nested objects are kept, as they also hold
+// non-secret values such as an access key ID next to its secret.
+func redactFeedSettings(settings map[string]interface{}) map[string]interface{} {
+	redacted := make(map[string]interface{}, len(settings))
+	for k, v := range settings {
+		if value, ok := v.(map[string]interface{}); ok {
+			redacted[k] = redactFeedSettings(value)
+			continue
+		}
+		if isFeedSecretSetting(k) {
+			continue
+		}
+
+		if values, ok := v.([]interface{}); ok {
+			items := make([]interface{}, 0, len(values))
+			for _, item := range values {
+				if itemMap, ok := item.(map[string]interface{}); ok {
+					item = redactFeedSettings(itemMap)
+				}
+				items = append(items, item)
+			}
+			v = items
+		}
+		redacted[k] = v
+	}
+
+	return redacted
+}
+
+func isFeedSecretSetting(name string) bool {
+	name = strings.ToLower(name)
+	for _, marker := range feedSecretMarkers {
+		if strings.Contains(name, marker) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,53 @@
+package chronicle
+
+import (
+	"fmt"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFeedServiceAccount() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFeedServiceAccountRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(FiveMinutesTimeout),
+		},
+
+		Description: `Returns the service account Chronicle reads Google Cloud sources as, e.g. the buckets of GCS V2 feeds.`,
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Email of the service account.`,
+			},
+			"member": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The service account as an IAM member, i.e. serviceAccount:<email>.`,
+			},
+		},
+	}
+}
+
+func dataSourceFeedServiceAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	email, err := client.FetchFeedServiceAccount()
+	if err != nil {
+		return fmt.Errorf("error fetching feed service account: %s", err)
+	}
+
+	d.SetId(email)
+
+	if err := d.Set("email", email); err != nil {
+		return fmt.Errorf("error reading Email: %s", err)
+	}
+	if err := d.Set("member", "serviceAccount:"+email); err != nil {
+		return fmt.Errorf("error reading Member: %s", err)
+	}
+
+	return nil
+}
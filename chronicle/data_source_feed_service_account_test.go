@@ -0,0 +1,31 @@
+package chronicle
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccChronicleDataSourceFeedServiceAccount_Basic(t *testing.T) {
+	t.Parallel()
+
+	rootRef := "data.chronicle_feed_service_account.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleDataSourceFeedServiceAccount(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(rootRef, "email", regexp.MustCompile(`^.+@.+\.iam\.gserviceaccount\.com$`)),
+					resource.TestMatchResourceAttr(rootRef, "member", regexp.MustCompile(`^serviceAccount:`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckChronicleDataSourceFeedServiceAccount() string {
+	return `data "chronicle_feed_service_account" "test" {}`
+}
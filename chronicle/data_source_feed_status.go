@@ -0,0 +1,80 @@
+package chronicle
+
+import (
+	"fmt"
+	"log"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFeedStatus() *schema.Resource {
+	resource := &schema.Resource{
+		Read: dataSourceFeedStatusRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(FiveMinutesTimeout),
+		},
+
+		Description: `Reads the health of a feed, e.g. to alert on feeds which silently stopped ingesting data.`,
+
+		Schema: map[string]*schema.Schema{
+			"feed_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `ID of the feed.`,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `State gives some insight into the current state of a feed.`,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `Enabled specifies whether a feed is allowed to be executed.`,
+			},
+			"healthy": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `Whether the last run of the feed succeeded, i.e. the feed is not in the FAILED state and has no failure message.`,
+			},
+		},
+	}
+
+	for attribute, attributeSchema := range feedStatusSchema() {
+		resource.Schema[attribute] = attributeSchema
+	}
+
+	return resource
+}
+
+func dataSourceFeedStatusRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	feedID := readStringFromResource(d, "feed_id")
+
+	feed, _, err := client.ReadGenericFeed(feedID)
+	if err != nil {
+		return fmt.Errorf("error reading Feed %q: %s", feedID, err)
+	}
+
+	d.SetId(feed.Name)
+
+	if err := d.Set("state", feed.State); err != nil {
+		return fmt.Errorf("error reading State: %s", err)
+	}
+	if err := d.Set("enabled", feed.State != FeedStateInactive); err != nil {
+		return fmt.Errorf("error reading Enabled: %s", err)
+	}
+	if err := d.Set("healthy", feed.State != FeedStateFailed && feed.FailureMsg == ""); err != nil {
+		return fmt.Errorf("error reading Healthy: %s", err)
+	}
+	if err := setFeedStatusProperties(d, *feed); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished reading status of Feed %q", d.Id())
+
+	return nil
+}
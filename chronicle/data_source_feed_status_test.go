@@ -0,0 +1,117 @@
+package chronicle
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccChronicleDataSourceFeedStatus_Basic(t *testing.T) {
+	displayName := "test" + randString(10)
+
+	rootRef := "data.chronicle_feed_status.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedAmazonS3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleDataSourceFeedStatus(displayName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(rootRef, "id", feedAmazonS3Ref("test"), "id"),
+					resource.TestCheckResourceAttrPair(rootRef, "state", feedAmazonS3Ref("test"), "state"),
+					resource.TestCheckResourceAttr(rootRef, "enabled", "false"),
+					resource.TestCheckResourceAttrSet(rootRef, "healthy"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenFeedStatus(t *testing.T) {
+	feed := chronicle.BaseFeed{
+		State:      FeedStateFailed,
+		FailureMsg: "access denied",
+		FailureDetails: &chronicle.FeedFailureDetails{
+			ErrorCode:     "PERMISSION_DENIED",
+			HTTPErrorCode: 403,
+			ErrorCause:    "access denied",
+			ErrorAction:   "grant access",
+		},
+		LastFeedInitiationTime:      "2024-01-01T00:00:00Z",
+		LastSuccessfulIngestionTime: "2023-12-31T00:00:00Z",
+	}
+
+	expected := map[string]interface{}{
+		"failure_message": "access denied",
+		"failure_details": []map[string]interface{}{{
+			"error_code":      "PERMISSION_DENIED",
+			"http_error_code": 403,
+			"error_cause":     "access denied",
+			"error_action":    "grant access",
+		}},
+		"last_run_time":                  "2024-01-01T00:00:00Z",
+		"last_successful_ingestion_time": "2023-12-31T00:00:00Z",
+	}
+
+	if status := flattenFeedStatus(feed); !reflect.DeepEqual(status, expected) {
+		t.Errorf("expected %v, got %v", expected, status)
+	}
+}
+
+func TestFeedFailureMessage(t *testing.T) {
+	cases := map[string]chronicle.BaseFeed{
+		"access denied":            {FailureMsg: "access denied", FailureDetails: &chronicle.FeedFailureDetails{ErrorCode: "PERMISSION_DENIED"}},
+		"PERMISSION_DENIED: cause": {FailureDetails: &chronicle.FeedFailureDetails{ErrorCode: "PERMISSION_DENIED", ErrorCause: "cause"}},
+		"no failure message":       {},
+	}
+
+	for expected, feed := range cases {
+		if message := feedFailureMessage(feed); message != expected {
+			t.Errorf("expected %q, got %q", expected, message)
+		}
+	}
+}
+
+func TestFeedRunStartedAfter(t *testing.T) {
+	update := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	cases := map[string]bool{
+		"":                               false,
+		"2024-01-01T11:59:59Z":           false,
+		"2024-01-01T12:00:00.000000001Z": true,
+		"2024-01-01T12:05:00Z":           true,
+	}
+
+	for lastRunTime, expected := range cases {
+		if started := feedRunStartedAfter(chronicle.BaseFeed{LastFeedInitiationTime: lastRunTime}, update); started != expected {
+			t.Errorf("%q: expected %t, got %t", lastRunTime, expected, started)
+		}
+	}
+}
+
+func testAccCheckChronicleDataSourceFeedStatus(displayName string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_feed_amazon_s3" "test" {
+			display_name = "%s"
+			log_type     = "GITHUB"
+			enabled      = false
+			details {
+				s3_uri                = "s3://test/"
+				s3_source_type        = "FILES"
+				source_delete_options = "SOURCE_DELETION_NEVER"
+				authentication {
+					region            = "EU_WEST_1"
+					access_key_id     = "XXXXXXXXXXXXXXXXXXXX"
+					secret_access_key = "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+				}
+			}
+		}
+
+		data "chronicle_feed_status" "test" {
+			feed_id = chronicle_feed_amazon_s3.test.id
+		}`, displayName)
+}
@@ -0,0 +1,103 @@
+package chronicle
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccChronicleDataSourceFeed_Basic(t *testing.T) {
+	displayName := "test" + randString(10)
+	secretAccessKey := "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+	rootRef := "data.chronicle_feed.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedAmazonS3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleDataSourceFeed(displayName, secretAccessKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(rootRef, "id", feedAmazonS3Ref("test"), "id"),
+					resource.TestCheckResourceAttr(rootRef, "display_name", displayName),
+					resource.TestCheckResourceAttr(rootRef, "log_type", "GITHUB"),
+					resource.TestCheckResourceAttr(rootRef, "feed_source_type", "AMAZON_S3"),
+					resource.TestCheckResourceAttr(rootRef, "labels.test", "test"),
+					resource.TestMatchResourceAttr(rootRef, "details_json", regexp.MustCompile(`"s3Uri"`)),
+					resource.TestCheckResourceAttrWith(rootRef, "details_json", func(value string) error {
+						if strings.Contains(value, secretAccessKey) {
+							return fmt.Errorf("details_json contains the secret access key")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestRedactFeedSettings(t *testing.T) {
+	settings := map[string]interface{}{
+		"s3Uri": "s3://bucket",
+		"authentication": map[string]interface{}{
+			"region":          "EU_WEST_1",
+			"accessKeyId":     "id",
+			"secretAccessKey": "secret",
+		},
+		"sqsAccessKeySecretAuth": map[string]interface{}{
+			"accessKeyId":     "id",
+			"secretAccessKey": "secret",
+		},
+		"headerKeyValues": []interface{}{
+			map[string]interface{}{"key": "Authorization", "value": "secret"},
+		},
+		"sasToken": "secret",
+	}
+
+	expected := map[string]interface{}{
+		"s3Uri": "s3://bucket",
+		"authentication": map[string]interface{}{
+			"region":      "EU_WEST_1",
+			"accessKeyId": "id",
+		},
+		"sqsAccessKeySecretAuth": map[string]interface{}{
+			"accessKeyId": "id",
+		},
+	}
+
+	if redacted := redactFeedSettings(settings); !reflect.DeepEqual(redacted, expected) {
+		t.Errorf("expected %v, got %v", expected, redacted)
+	}
+}
+
+func testAccCheckChronicleDataSourceFeed(displayName, secretAccessKey string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_feed_amazon_s3" "test" {
+			display_name = "%s"
+			log_type     = "GITHUB"
+			enabled      = true
+			namespace    = "test"
+			labels = {
+				"test" = "test"
+			}
+			details {
+				s3_uri                = "s3://test/"
+				s3_source_type        = "FILES"
+				source_delete_options = "SOURCE_DELETION_NEVER"
+				authentication {
+					region            = "EU_WEST_1"
+					access_key_id     = "XXXXXXXXXXXXXXXXXXXX"
+					secret_access_key = "%s"
+				}
+			}
+		}
+
+		data "chronicle_feed" "test" {
+			feed_id = chronicle_feed_amazon_s3.test.id
+		}`, displayName, secretAccessKey)
+}
@@ -0,0 +1,97 @@
+package chronicle
+
+import (
+	"fmt"
+	"log"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFeeds() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFeedsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(FiveMinutesTimeout),
+		},
+
+		Description: `Lists every feed, including feeds not managed by this configuration.`,
+
+		Schema: map[string]*schema.Schema{
+			"feeds": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Feeds of the Chronicle instance.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `ID of the feed.`,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Name to be displayed.`,
+						},
+						"log_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Log Type is a label which describes the nature of the data being ingested.`,
+						},
+						"feed_source_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Feed Source Type describes how data is collected.`,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `State gives some insight into the current state of a feed.`,
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Enabled specifies whether a feed is allowed to be executed.`,
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The namespace the feed is associated with.`,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `Labels applied to the events of the feed.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFeedsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	feeds, err := client.ListFeeds()
+	if err != nil {
+		return fmt.Errorf("error listing Feeds: %s", err)
+	}
+
+	d.SetId("feeds")
+
+	result := make([]map[string]interface{}, 0, len(feeds))
+	for _, feed := range feeds {
+		result = append(result, flattenFeedSummary(feed))
+	}
+	if err := d.Set("feeds", result); err != nil {
+		return fmt.Errorf("error reading Feeds: %s", err)
+	}
+
+	log.Printf("[DEBUG] Finished listing %d Feeds", len(feeds))
+
+	return nil
+}
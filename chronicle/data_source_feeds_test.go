@@ -0,0 +1,71 @@
+package chronicle
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var regexpFeedDisplayNameAttribute = regexp.MustCompile(`^feeds\.\d+\.display_name$`)
+
+func TestAccChronicleDataSourceFeeds_Basic(t *testing.T) {
+	displayName := "test" + randString(10)
+
+	rootRef := "data.chronicle_feeds.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedAmazonS3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleDataSourceFeeds(displayName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleDataSourceFeedsContains(rootRef, displayName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckChronicleDataSourceFeeds(displayName string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_feed_amazon_s3" "test" {
+			display_name = "%s"
+			log_type     = "GITHUB"
+			enabled      = true
+			details {
+				s3_uri                = "s3://test/"
+				s3_source_type        = "FILES"
+				source_delete_options = "SOURCE_DELETION_NEVER"
+				authentication {
+					region            = "EU_WEST_1"
+					access_key_id     = "XXXXXXXXXXXXXXXXXXXX"
+					secret_access_key = "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+				}
+			}
+		}
+
+		data "chronicle_feeds" "test" {
+			depends_on = [chronicle_feed_amazon_s3.test]
+		}`, displayName)
+}
+
+func testAccCheckChronicleDataSourceFeedsContains(n, displayName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return NewNotFoundErrorf("%s in state", n)
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if regexpFeedDisplayNameAttribute.MatchString(k) && v == displayName {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("feed %q not found in %s", displayName, n)
+	}
+}
@@ -0,0 +1,65 @@
+package chronicle
+
+import (
+	"fmt"
+	"log"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceForwarderConfig() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceForwarderConfigRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(FiveMinutesTimeout),
+		},
+
+		Description: `Generates the configuration and authentication files to deploy along with a forwarder.`,
+
+		Schema: map[string]*schema.Schema{
+			"forwarder_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `ID of the forwarder.`,
+			},
+			"config": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: `YAML configuration file of the forwarder and its collectors.`,
+			},
+			"auth": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: `YAML authentication file of the forwarder.`,
+			},
+		},
+	}
+}
+
+func dataSourceForwarderConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	forwarderID := readStringFromResource(d, "forwarder_id")
+
+	files, err := client.GenerateForwarderFiles(forwarderID)
+	if err != nil {
+		return fmt.Errorf("error generating files of Forwarder %q: %s", forwarderID, err)
+	}
+
+	d.SetId(forwarderID)
+
+	if err := d.Set("config", files.Config); err != nil {
+		return fmt.Errorf("error reading Config: %s", err)
+	}
+	if err := d.Set("auth", files.Auth); err != nil {
+		return fmt.Errorf("error reading Auth: %s", err)
+	}
+
+	log.Printf("[DEBUG] Finished generating files of Forwarder %q", forwarderID)
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package chronicle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccChronicleDataSourceForwarderConfig_Basic(t *testing.T) {
+	t.Parallel()
+	displayName := fmt.Sprintf("test%s", randString(10))
+
+	rootRef := "data.chronicle_forwarder_config.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleDataSourceForwarderConfig(displayName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(rootRef, "forwarder_id", "chronicle_forwarder.test", "id"),
+					resource.TestCheckResourceAttrSet(rootRef, "config"),
+					resource.TestCheckResourceAttrSet(rootRef, "auth"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckChronicleDataSourceForwarderConfig(displayName string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_forwarder" "test" {
+			display_name = "%s"
+		}
+
+		data "chronicle_forwarder_config" "test" {
+			forwarder_id = chronicle_forwarder.test.id
+		}`, displayName)
+}
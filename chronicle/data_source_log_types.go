@@ -0,0 +1,74 @@
+package chronicle
+
+import (
+	"fmt"
+	"log"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLogTypes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLogTypesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(FiveMinutesTimeout),
+		},
+
+		Description: `Lists the log types supported by Chronicle.`,
+
+		Schema: map[string]*schema.Schema{
+			"log_types": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Supported log types.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Log type, as expected by the log_type attribute of feeds.`,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Human readable name of the log type.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLogTypesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	logTypes, err := client.ListLogTypes()
+	if err != nil {
+		return fmt.Errorf("error listing log types: %s", err)
+	}
+
+	d.SetId("log_types")
+
+	if err := d.Set("log_types", flattenLogTypes(logTypes)); err != nil {
+		return fmt.Errorf("error reading LogTypes: %s", err)
+	}
+
+	log.Printf("[DEBUG] Finished listing %d log types", len(logTypes))
+
+	return nil
+}
+
+func flattenLogTypes(logTypes []chronicle.LogType) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(logTypes))
+	for _, logType := range logTypes {
+		result = append(result, map[string]interface{}{
+			"log_type":     logType.LogType,
+			"display_name": logType.DisplayName,
+		})
+	}
+
+	return result
+}
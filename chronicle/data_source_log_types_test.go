@@ -0,0 +1,75 @@
+package chronicle
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccChronicleDataSourceLogTypes_Basic(t *testing.T) {
+	t.Parallel()
+
+	rootRef := "data.chronicle_log_types.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleDataSourceLogTypes(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(rootRef, "log_types.#"),
+					resource.TestCheckResourceAttrSet(rootRef, "log_types.0.log_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckChronicleDataSourceLogTypes() string {
+	return `data "chronicle_log_types" "test" {}`
+}
+
+func TestValidateLogType(t *testing.T) {
+	available := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !available {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"logTypes": []interface{}{map[string]interface{}{"logType": "AWS_CLOUDTRAIL", "label": "AWS CloudTrail"}},
+		})
+	}))
+	defer server.Close()
+
+	newClient := func(validate bool) *chronicle.Client {
+		client, err := chronicle.NewClient(chronicle.RegionEurope, "test", context.Background(),
+			chronicle.WithBackstoryAPIAccessToken("token"), chronicle.WithLogTypeValidation(validate))
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		client.WithLogTypesBasePath(server.URL + "/v2/logtypes")
+		return client
+	}
+
+	if err := newClient(false).ValidateLogType("UNKNOWN"); err != nil {
+		t.Errorf("expected log types not to be validated by default, got %s", err)
+	}
+	client := newClient(true)
+	if err := client.ValidateLogType("AWS_CLOUDTRAIL"); err != nil {
+		t.Errorf("err: %s", err)
+	}
+	if err := client.ValidateLogType("UNKNOWN"); err == nil {
+		t.Errorf("expected unsupported log type to fail validation")
+	}
+
+	available = false
+	if err := newClient(true).ValidateLogType("UNKNOWN"); err != nil {
+		t.Errorf("expected log types not to be validated when they cannot be listed, got %s", err)
+	}
+}
@@ -1,7 +1,9 @@
 package chronicle
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -33,6 +35,8 @@ type ConcreteFeedResource interface {
 }
 
 func newFeedResourceSchema(details *schema.Resource, concreteFeed ConcreteFeedResource, description string, withLogType bool) *schema.Resource {
+	secretPaths := addWriteOnlySecretAttributes("details.0.", details)
+
 	resource := &schema.Resource{
 		Create: func(d *schema.ResourceData, meta interface{}) error {
 			return resourceFeedCreate(d, meta, concreteFeed.expandConcreteFeedConfiguration, concreteFeed.flattenDetailsFromReadOperation, concreteFeed.getLogType())
@@ -46,7 +50,7 @@ func newFeedResourceSchema(details *schema.Resource, concreteFeed ConcreteFeedRe
 		Delete: resourceFeedDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceFeedImportState,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -108,14 +112,42 @@ func newFeedResourceSchema(details *schema.Resource, concreteFeed ConcreteFeedRe
 		},
 	}
 
-	if !withLogType {
+	for attribute, attributeSchema := range feedStatusSchema() {
+		resource.Schema[attribute] = attributeSchema
+	}
+	for attribute, attributeSchema := range feedSchedulingSchema() {
+		resource.Schema[attribute] = attributeSchema
+	}
+	resource.Schema["wait_for_first_run"] = waitForFirstRunSchema()
+
+	if withLogType {
+		resource.CustomizeDiff = validateFeedLogTypeDiff
+	} else {
 		resource.Schema["log_type"].Required = false
 		resource.Schema["log_type"].Computed = true
 	}
 
+	if len(secretPaths) > 0 {
+		addFeedSecretTracking(resource, secretPaths)
+	}
+
 	return resource
 }
 
+// validateFeedLogTypeDiff catches unsupported log types at plan time instead of when the feed is created.
+func validateFeedLogTypeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*chronicle.Client)
+	if !ok || !d.HasChange("log_type") || !d.NewValueKnown("log_type") {
+		return nil
+	}
+
+	if err := client.ValidateLogType(d.Get("log_type").(string)); err != nil {
+		return fmt.Errorf("error validating log_type: %s. Unset validate_log_types in the provider configuration to skip this check", err)
+	}
+
+	return nil
+}
+
 func resourceFeedRead(d *schema.ResourceData, meta interface{}, expandFunc ConcreteFeedExpandFunc, flattenDetailsFromConcreteConfiguration ConcreteFeedFlattenFunc) error {
 	client := meta.(*chronicle.Client)
 
@@ -123,11 +155,20 @@ func resourceFeedRead(d *schema.ResourceData, meta interface{}, expandFunc Concr
 	if err != nil {
 		return HandleNotFoundError(err, d, d.Id())
 	}
+	if _, ok := (*concreteFeed).(*chronicle.GenericFeedConfiguration); ok {
+		return fmt.Errorf("feed %s has source type %s and log type %s, which are not supported by this resource, use chronicle_feed instead",
+			d.Id(), baseFeed.Details.SourceType, baseFeed.Details.LogType)
+	}
 
 	err = setBaseFeedProperties(d, *baseFeed)
 	if err != nil {
 		return err
 	}
+	if !isPushFeedSourceType(baseFeed.Details.SourceType) {
+		if err := setFeedSchedulingProperties(d, baseFeed.Details.FeedScheduling); err != nil {
+			return err
+		}
+	}
 
 	details := flattenDetailsFromConcreteConfiguration(expandFunc(d), *concreteFeed)
 	if err := d.Set("details", details); err != nil {
@@ -142,6 +183,7 @@ func resourceFeedCreate(d *schema.ResourceData, meta interface{}, expandFunc Con
 	client := meta.(*chronicle.Client)
 
 	concreteFeed := expandFunc(d)
+	since := time.Now()
 
 	var logType string
 	if staticLogType != "" {
@@ -151,7 +193,7 @@ func resourceFeedCreate(d *schema.ResourceData, meta interface{}, expandFunc Con
 	}
 
 	id, err := client.CreateFeed(readStringFromResource(d, "display_name"), logType,
-		readStringFromResource(d, "namespace"), extractLabelsFromFeedResource(d), concreteFeed)
+		readStringFromResource(d, "namespace"), extractLabelsFromFeedResource(d), expandFeedScheduling(d), concreteFeed)
 	if err != nil {
 		return err
 	}
@@ -165,6 +207,10 @@ func resourceFeedCreate(d *schema.ResourceData, meta interface{}, expandFunc Con
 		}
 	}
 
+	if err := waitForFeedRun(d, client, d.Timeout(schema.TimeoutCreate), since); err != nil {
+		return err
+	}
+
 	return resourceFeedRead(d, meta, expandFunc, flattenDetailsFromConcreteConfiguration)
 }
 
@@ -172,22 +218,34 @@ func resourceFeedUpdate(d *schema.ResourceData, meta interface{}, expandFunc Con
 	client := meta.(*chronicle.Client)
 
 	concreteFeed := expandFunc(d)
+	updated := false
+	since := time.Now()
 
 	if d.HasChange("details") || d.HasChange("display_name") || d.HasChange("log_type") ||
-		d.HasChange("namespace") || d.HasChange("labels") {
+		d.HasChange("namespace") || d.HasChange("labels") || d.HasChange("secret_version") ||
+		d.HasChange("schedule") || d.HasChange("backfill_start_time") {
+		updated = true
 		err := client.UpdateFeed(d.Id(), readStringFromResource(d, "display_name"), readStringFromResource(d, "log_type"), readStringFromResource(d, "namespace"),
-			extractLabelsFromFeedResource(d), concreteFeed)
+			extractLabelsFromFeedResource(d), expandFeedScheduling(d), concreteFeed)
 		if err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("enabled") {
+		updated = true
 		err := client.ChangeEnableFeed(d.Id(), readBoolFromResource(d, "enabled"))
 		if err != nil {
 			return err
 		}
 	}
+
+	if updated {
+		if err := waitForFeedRun(d, client, d.Timeout(schema.TimeoutUpdate), since); err != nil {
+			return err
+		}
+	}
+
 	return resourceFeedRead(d, meta, expandFunc, flattenDetailsFromConcreteConfiguration)
 }
 
@@ -201,6 +259,15 @@ func resourceFeedDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// resourceFeedImportState imports a feed by ID, setting the attributes which are not read from the API to their default.
+func resourceFeedImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("wait_for_first_run", false); err != nil {
+		return nil, fmt.Errorf("error setting wait_for_first_run: %s", err)
+	}
+
+	return schema.ImportStatePassthroughContext(ctx, d, meta)
+}
+
 func setBaseFeedProperties(d *schema.ResourceData, feed chronicle.BaseFeed) error {
 	if err := d.Set("state", feed.State); err != nil {
 		return fmt.Errorf("error reading State: %s", err)
@@ -223,7 +290,7 @@ func setBaseFeedProperties(d *schema.ResourceData, feed chronicle.BaseFeed) erro
 		return fmt.Errorf("error setting enabled: %s", err)
 	}
 
-	return nil
+	return setFeedStatusProperties(d, feed)
 }
 
 func extractLabelsFromFeedResource(d *schema.ResourceData) []chronicle.Label {
@@ -0,0 +1,137 @@
+package chronicle
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// pushFeedSourceTypePrefix is shared by the source types of push feeds.
+const pushFeedSourceTypePrefix = "HTTPS_PUSH_"
+
+// isPushFeedSourceType tells whether feeds of a source type receive data, in which case they never run nor have a schedule.
+func isPushFeedSourceType(sourceType string) bool {
+	return strings.HasPrefix(sourceType, pushFeedSourceTypePrefix)
+}
+
+// newPushFeedResourceSchema returns the schema of a feed Chronicle receives data on instead of pulling it.
+// On top of the common feed attributes, it exposes the endpoint to send data to and the secret to authenticate with.
+func newPushFeedResourceSchema(details *schema.Resource, concreteFeed ConcreteFeedResource, description string, withLogType bool) *schema.Resource {
+	resource := newFeedResourceSchema(details, concreteFeed, description, withLogType)
+	for attribute := range feedSchedulingSchema() {
+		delete(resource.Schema, attribute)
+	}
+
+	resource.Schema["endpoint_url"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+		Description: `The endpoint the feed receives data on. Push feeds require the provider to target the Chronicle API,
+		i.e. api_version is not legacy.`,
+	}
+	resource.Schema["generate_secret"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+		Description: `Whether to generate a secret for the feed when it is created, or when this attribute is switched to true.
+		Generating a secret invalidates the previous one.`,
+	}
+	resource.Schema["secret_rotation_trigger"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: `An arbitrary value, e.g. a date, which rotates the secret whenever it changes.
+		It has no effect unless generate_secret is true.`,
+	}
+	resource.Schema["secret"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: `The secret generated for the feed. It is empty for imported feeds until a secret is generated.`,
+	}
+
+	create, read, update, customizeDiff := resource.Create, resource.Read, resource.Update, resource.CustomizeDiff
+	resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		if client, ok := meta.(*chronicle.Client); ok {
+			if err := client.CheckPushFeedsSupported(); err != nil {
+				return err
+			}
+		}
+
+		// A secret is generated by Update in these cases, so the one in state is stale.
+		if d.Id() != "" && d.Get("generate_secret").(bool) && (d.HasChange("generate_secret") || d.HasChange("secret_rotation_trigger")) {
+			if err := d.SetNewComputed("secret"); err != nil {
+				return fmt.Errorf("error setting secret as computed: %s", err)
+			}
+		}
+		return nil
+	}
+	resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+		if err := create(d, meta); err != nil {
+			return err
+		}
+		if readBoolFromResource(d, "generate_secret") {
+			if err := generatePushFeedSecret(d, meta); err != nil {
+				return err
+			}
+		}
+
+		return setPushFeedEndpointURL(d, meta)
+	}
+	resource.Read = func(d *schema.ResourceData, meta interface{}) error {
+		if err := read(d, meta); err != nil || d.Id() == "" {
+			return err
+		}
+
+		return setPushFeedEndpointURL(d, meta)
+	}
+	resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+		if err := update(d, meta); err != nil {
+			return err
+		}
+		if readBoolFromResource(d, "generate_secret") && (d.HasChange("generate_secret") || d.HasChange("secret_rotation_trigger")) {
+			if err := generatePushFeedSecret(d, meta); err != nil {
+				return err
+			}
+		}
+
+		return setPushFeedEndpointURL(d, meta)
+	}
+
+	return resource
+}
+
+func generatePushFeedSecret(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	secret, err := client.GenerateFeedSecret(d.Id())
+	if err != nil {
+		return err
+	}
+	if err := d.Set("secret", secret); err != nil {
+		return fmt.Errorf("error setting secret: %s", err)
+	}
+
+	return nil
+}
+
+func setPushFeedEndpointURL(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	endpointURL, err := client.PushFeedEndpointURL(d.Id())
+	if err != nil {
+		return err
+	}
+	if err := d.Set("endpoint_url", endpointURL); err != nil {
+		return fmt.Errorf("error setting endpoint_url: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,327 @@
+package chronicle
+
+import (
+	"strconv"
+	"strings"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// feedTypeSpec declares a feed type from which the chronicle_feed_<Name> resource is generated: its schema,
+// how its details are expanded into the settings of the feed and flattened back, and its documentation.
+type feedTypeSpec struct {
+	Name        string
+	Type        chronicle.FeedType
+	Description string
+	// Example is the path of the example shown in the documentation of the resource.
+	Example string
+	Fields  []feedFieldSpec
+}
+
+// feedFieldSpec declares an attribute of the details block of a feed. Attributes with Fields are blocks of at most one element,
+// the other attributes hold the value found at Path in the settings of the feed, e.g. authentication.headerKeyValues.0.value,
+// where numeric segments are list indexes. Attributes are strings unless Type is set.
+type feedFieldSpec struct {
+	Name         string
+	Path         string
+	Type         schema.ValueType
+	Required     bool
+	Computed     bool
+	Sensitive    bool
+	Default      interface{}
+	Validate     schema.SchemaValidateDiagFunc
+	ExactlyOneOf []string
+	Description  string
+	// KeepConfigured flattens the configured value rather than the one read, for values the API does not return as sent.
+	// Sensitive values are always kept as configured.
+	KeepConfigured bool
+	// Expand converts the configured value into settings by path, for values which are not sent as configured, e.g. a service
+	// account key sent as its email and private key. Such values cannot be read back and are always kept as configured.
+	Expand func(value interface{}) map[string]interface{}
+
+	Fields []feedFieldSpec
+	// When and Unless are paths in the settings of the feed which must respectively be set and unset for a block to be read.
+	// Optional blocks without either are read when any of their values is set.
+	When   string
+	Unless string
+}
+
+// registeredFeedResource implements ConcreteFeedResource from a feedTypeSpec.
+type registeredFeedResource struct {
+	spec feedTypeSpec
+}
+
+func newRegisteredFeedResource(spec feedTypeSpec) *schema.Resource {
+	details := &schema.Resource{Schema: feedFieldsSchema(spec.Fields)}
+
+	return newFeedResourceSchema(details, &registeredFeedResource{spec: spec}, spec.Description, spec.Type.LogType == "")
+}
+
+// registeredFeedResources returns the resources of every feed type of feedTypeSpecs, by resource name.
+func registeredFeedResources() map[string]*schema.Resource {
+	resources := make(map[string]*schema.Resource, len(feedTypeSpecs))
+	for _, spec := range feedTypeSpecs {
+		resources["chronicle_feed_"+spec.Name] = newRegisteredFeedResource(spec)
+	}
+
+	return resources
+}
+
+func feedFieldsSchema(fields []feedFieldSpec) map[string]*schema.Schema {
+	attributes := make(map[string]*schema.Schema, len(fields))
+	for _, field := range fields {
+		attributes[field.Name] = feedFieldSchema(field)
+	}
+
+	return attributes
+}
+
+func feedFieldSchema(field feedFieldSpec) *schema.Schema {
+	attribute := &schema.Schema{
+		Type:             field.fieldType(),
+		Required:         field.Required,
+		Optional:         !field.Required && !field.Computed,
+		Computed:         field.Computed,
+		Sensitive:        field.Sensitive,
+		Default:          field.Default,
+		ValidateDiagFunc: field.Validate,
+		ExactlyOneOf:     field.ExactlyOneOf,
+		Description:      field.Description,
+	}
+	switch {
+	case len(field.Fields) > 0:
+		attribute.MaxItems = 1
+		attribute.Elem = &schema.Resource{Schema: feedFieldsSchema(field.Fields)}
+	case attribute.Type == schema.TypeList:
+		// lists are validated by element
+		attribute.Elem = &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: field.Validate}
+		attribute.ValidateDiagFunc = nil
+	}
+
+	return attribute
+}
+
+func (field feedFieldSpec) fieldType() schema.ValueType {
+	switch {
+	case len(field.Fields) > 0:
+		return schema.TypeList
+	case field.Type == schema.TypeInvalid:
+		return schema.TypeString
+	default:
+		return field.Type
+	}
+}
+
+func (f *registeredFeedResource) getLogType() string {
+	return f.spec.Type.LogType
+}
+
+func (f *registeredFeedResource) expandConcreteFeedConfiguration(d *schema.ResourceData) chronicle.ConcreteFeedConfiguration {
+	details := readSingleBlockFromResource(d, "details")
+	if details == nil {
+		return nil
+	}
+
+	conf := &chronicle.RegisteredFeedConfiguration{FeedType: f.spec.Type, Settings: map[string]interface{}{}, Configured: map[string]interface{}{}}
+	expandFeedFields(f.spec.Fields, details, d.GetRawConfig(), "", conf)
+
+	return conf
+}
+
+// expandFeedFields sets the values of fields into the settings of conf, leaving out computed values and unset values,
+// i.e. zero values which are not in rawConfig, so that explicit zero values such as false are sent.
+// Values converted by Expand are also recorded in the configured values of conf, by attribute path relative to details.
+func expandFeedFields(fields []feedFieldSpec, block map[string]interface{}, rawConfig cty.Value, prefix string, conf *chronicle.RegisteredFeedConfiguration) {
+	for _, field := range fields {
+		if len(field.Fields) > 0 {
+			if nested, ok := block[field.Name].([]interface{}); ok && len(nested) > 0 && nested[0] != nil {
+				expandFeedFields(field.Fields, nested[0].(map[string]interface{}), rawConfig, prefix+field.Name+".0.", conf)
+			}
+			continue
+		}
+		if field.Computed || (isEmptyFeedSetting(block[field.Name]) && !isFeedFieldConfigured(rawConfig, "details.0."+prefix+field.Name)) {
+			continue
+		}
+		if field.Expand != nil {
+			conf.Configured[prefix+field.Name] = block[field.Name]
+			for path, value := range field.Expand(block[field.Name]) {
+				setFeedSetting(conf.Settings, path, value)
+			}
+			continue
+		}
+		setFeedSetting(conf.Settings, field.Path, block[field.Name])
+	}
+}
+
+func (f *registeredFeedResource) flattenDetailsFromReadOperation(originalConf chronicle.ConcreteFeedConfiguration, readConf chronicle.ConcreteFeedConfiguration) []map[string]interface{} {
+	read := readConf.(*chronicle.RegisteredFeedConfiguration)
+	// originalConf is nil on import.
+	original, _ := originalConf.(*chronicle.RegisteredFeedConfiguration)
+
+	return []map[string]interface{}{flattenFeedFields(f.spec.Fields, "", original, read)}
+}
+
+// flattenFeedFields returns the values of fields as read, except for the values which are kept as configured in original,
+// which is nil on import.
+func flattenFeedFields(fields []feedFieldSpec, prefix string, original, read *chronicle.RegisteredFeedConfiguration) map[string]interface{} {
+	var originalSettings map[string]interface{}
+	if original != nil {
+		originalSettings = original.Settings
+	}
+
+	block := map[string]interface{}{}
+	for _, field := range fields {
+		switch {
+		case len(field.Fields) > 0:
+			if isFeedBlockRead(field, originalSettings, read.Settings) {
+				block[field.Name] = []map[string]interface{}{flattenFeedFields(field.Fields, prefix+field.Name+".0.", original, read)}
+			}
+		case field.Expand != nil:
+			var value interface{}
+			if original != nil {
+				value = original.Configured[prefix+field.Name]
+			}
+			block[field.Name] = flattenFeedSetting(field.fieldType(), value)
+		case original != nil && (field.Sensitive || field.KeepConfigured):
+			block[field.Name] = flattenFeedSetting(field.fieldType(), getFeedSetting(originalSettings, field.Path))
+		default:
+			block[field.Name] = flattenFeedSetting(field.fieldType(), getFeedSetting(read.Settings, field.Path))
+		}
+	}
+
+	return block
+}
+
+func isFeedBlockRead(field feedFieldSpec, original, read map[string]interface{}) bool {
+	isSet := func(path string) bool {
+		return !isEmptyFeedSetting(getFeedSetting(original, path)) || !isEmptyFeedSetting(getFeedSetting(read, path))
+	}
+
+	switch {
+	case field.When != "":
+		return isSet(field.When)
+	case field.Unless != "":
+		return !isSet(field.Unless)
+	case field.Required:
+		return true
+	}
+
+	for _, nested := range field.Fields {
+		if len(nested.Fields) > 0 && isFeedBlockRead(nested, original, read) || nested.Path != "" && !nested.Computed && isSet(nested.Path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// flattenFeedSetting converts a setting, as configured or as decoded from JSON, to the type of its attribute.
+func flattenFeedSetting(valueType schema.ValueType, value interface{}) interface{} {
+	switch valueType {
+	case schema.TypeBool:
+		b, _ := value.(bool)
+		return b
+	case schema.TypeInt:
+		switch n := value.(type) {
+		case int:
+			return n
+		case float64:
+			return int(n)
+		case string:
+			i, _ := strconv.Atoi(n)
+			return i
+		}
+		return 0
+	case schema.TypeList:
+		list, _ := value.([]interface{})
+		return list
+	default:
+		s, _ := value.(string)
+		return s
+	}
+}
+
+// isFeedFieldConfigured tells whether the attribute at path is set in rawConfig, which is null when reading feeds.
+func isFeedFieldConfigured(rawConfig cty.Value, path string) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+	value, err := feedCtyPath(path).Apply(rawConfig)
+
+	return err == nil && !value.IsNull()
+}
+
+func isEmptyFeedSetting(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+
+	return false
+}
+
+// getFeedSetting returns the value found at path in settings, nil if there is none.
+func getFeedSetting(settings map[string]interface{}, path string) interface{} {
+	var value interface{} = settings
+	for _, step := range strings.Split(path, ".") {
+		switch container := value.(type) {
+		case map[string]interface{}:
+			value = container[step]
+		case []interface{}:
+			index, err := strconv.Atoi(step)
+			if err != nil || index >= len(container) {
+				return nil
+			}
+			value = container[index]
+		default:
+			return nil
+		}
+	}
+
+	return value
+}
+
+// setFeedSetting sets value at path in settings, creating the objects and lists along the way.
+func setFeedSetting(settings map[string]interface{}, path string, value interface{}) {
+	steps := strings.Split(path, ".")
+	settings[steps[0]] = setFeedSettingStep(settings[steps[0]], steps[1:], value)
+}
+
+func setFeedSettingStep(container interface{}, steps []string, value interface{}) interface{} {
+	if len(steps) == 0 {
+		return value
+	}
+
+	index, err := strconv.Atoi(steps[0])
+	if err != nil {
+		object, ok := container.(map[string]interface{})
+		if !ok {
+			object = map[string]interface{}{}
+		}
+		object[steps[0]] = setFeedSettingStep(object[steps[0]], steps[1:], value)
+
+		return object
+	}
+
+	list, _ := container.([]interface{})
+	for len(list) <= index {
+		list = append(list, nil)
+	}
+	list[index] = setFeedSettingStep(list[index], steps[1:], value)
+
+	return list
+}
@@ -0,0 +1,39 @@
+package chronicle
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// feedResourceTemplate is the tfplugindocs template of the resources generated from feedTypeSpecs.
+const feedResourceTemplate = `---
+page_title: "{{.Name}} {{.Type}} - {{.ProviderName}}"
+subcategory: ""
+description: |-
+{{ .Description | plainmarkdown | trimspace | prefixlines "  " }}
+---
+
+# {{.Name}} ({{.Type}})
+
+{{ .Description | trimspace }}
+
+## Example Usage
+
+{{ tffile "%s" }}
+
+{{ .SchemaMarkdown | trimspace }}
+`
+
+// WriteFeedResourceTemplates writes the documentation template of every feed type of feedTypeSpecs into dir,
+// e.g. templates/resources, from which tfplugindocs generates their documentation.
+func WriteFeedResourceTemplates(dir string) error {
+	for _, spec := range feedTypeSpecs {
+		path := filepath.Join(dir, "feed_"+spec.Name+".md.tmpl")
+		if err := os.WriteFile(path, []byte(fmt.Sprintf(feedResourceTemplate, spec.Example)), 0o644); err != nil { //nolint:gosec
+			return fmt.Errorf("error writing template of feed %s: %s", spec.Name, err)
+		}
+	}
+
+	return nil
+}
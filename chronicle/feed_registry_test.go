@@ -0,0 +1,442 @@
+package chronicle
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestFeedTypeSpecs(t *testing.T) {
+	names := map[string]bool{}
+	for _, spec := range feedTypeSpecs {
+		if names[spec.Name] {
+			t.Errorf("feed type %s is declared twice", spec.Name)
+		}
+		names[spec.Name] = true
+
+		if _, err := os.Stat(filepath.Join("..", spec.Example)); err != nil {
+			t.Errorf("example of feed type %s: %s", spec.Name, err)
+		}
+		checkFeedFieldPaths(t, spec.Name, spec.Fields)
+	}
+}
+
+func checkFeedFieldPaths(t *testing.T, name string, fields []feedFieldSpec) {
+	for _, field := range fields {
+		if len(field.Fields) > 0 {
+			checkFeedFieldPaths(t, name, field.Fields)
+		} else if field.Path == "" && field.Expand == nil {
+			t.Errorf("field %s of feed type %s has no path", field.Name, name)
+		}
+	}
+}
+
+func TestRegisteredFeedResourceExpand(t *testing.T) {
+	cases := []struct {
+		spec     feedTypeSpec
+		details  map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			spec: feedTypeAmazonS3,
+			details: map[string]interface{}{
+				"s3_uri":                "s3://bucket/",
+				"s3_source_type":        "FILES",
+				"source_delete_options": "SOURCE_DELETION_NEVER",
+				"authentication": []interface{}{map[string]interface{}{
+					"region":            "EU_WEST_1",
+					"access_key_id":     "id",
+					"secret_access_key": "secret",
+				}},
+			},
+			expected: map[string]interface{}{
+				"s3Uri":                "s3://bucket/",
+				"sourceType":           "FILES",
+				"sourceDeletionOption": "SOURCE_DELETION_NEVER",
+				"authentication": map[string]interface{}{
+					"region":          "EU_WEST_1",
+					"accessKeyId":     "id",
+					"secretAccessKey": "secret",
+				},
+			},
+		},
+		{
+			spec: feedTypeAmazonS3,
+			details: map[string]interface{}{
+				"s3_uri":                "s3://bucket/",
+				"s3_source_type":        "FILES",
+				"source_delete_options": "SOURCE_DELETION_NEVER",
+				"role_authentication": []interface{}{map[string]interface{}{
+					"region":   "EU_WEST_1",
+					"role_arn": "arn:aws:iam::123456789012:role/chronicle",
+				}},
+			},
+			expected: map[string]interface{}{
+				"s3Uri":                "s3://bucket/",
+				"sourceType":           "FILES",
+				"sourceDeletionOption": "SOURCE_DELETION_NEVER",
+				"authentication": map[string]interface{}{
+					"region":         "EU_WEST_1",
+					"awsIamRoleAuth": map[string]interface{}{"awsIamRoleArn": "arn:aws:iam::123456789012:role/chronicle"},
+				},
+			},
+		},
+		{
+			spec: feedTypeThinkstCanary,
+			details: map[string]interface{}{
+				"hostname":       "test.canary.tools",
+				"authentication": []interface{}{map[string]interface{}{"value": "token"}},
+			},
+			expected: map[string]interface{}{
+				"hostname": "test.canary.tools",
+				"authentication": map[string]interface{}{
+					"headerKeyValues": []interface{}{map[string]interface{}{"key": "auth_token", "value": "token"}},
+				},
+			},
+		},
+		{
+			spec: feedTypeGoogleWorkspaceUsers,
+			details: map[string]interface{}{
+				"customer_id": "C01234567",
+				"authentication": []interface{}{map[string]interface{}{
+					"admin_email":          "admin@example.com",
+					"service_account_json": testGoogleServiceAccountKey,
+				}},
+			},
+			expected: map[string]interface{}{
+				"workspaceCustomerId": "C01234567",
+				"authentication": map[string]interface{}{
+					"tokenEndpoint": googleOAuthTokenURI,
+					"claims": map[string]interface{}{
+						"issuer":   "feed@project.iam.gserviceaccount.com",
+						"subject":  "admin@example.com",
+						"audience": googleOAuthTokenURI,
+					},
+					"rsCredentials": map[string]interface{}{"privateKey": "key"},
+				},
+			},
+		},
+		{
+			spec: feedTypeBox,
+			details: map[string]interface{}{
+				"authentication": []interface{}{map[string]interface{}{
+					"app_config_json": `{"boxAppSettings": {"clientID": "id", "clientSecret": "secret", "appAuth": {"publicKeyID": "kid", "privateKey": "key"}},
+						"enterpriseID": "123"}`,
+				}},
+			},
+			expected: map[string]interface{}{
+				"authentication": map[string]interface{}{
+					"clientId":     "id",
+					"clientSecret": "secret",
+					"publicKeyId":  "kid",
+					"privateKey":   "key",
+					"enterpriseId": "123",
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		resource := newRegisteredFeedResource(c.spec)
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"details": []interface{}{c.details}})
+
+		conf := (&registeredFeedResource{spec: c.spec}).expandConcreteFeedConfiguration(d).(*chronicle.RegisteredFeedConfiguration)
+		if conf.FeedType != c.spec.Type {
+			t.Errorf("expected feed type %+v, got %+v", c.spec.Type, conf.FeedType)
+		}
+		if !reflect.DeepEqual(conf.Settings, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, conf.Settings)
+		}
+	}
+}
+
+func TestExpandFeedFieldsZeroValues(t *testing.T) {
+	fields := []feedFieldSpec{
+		{Name: "explicit", Path: "explicit", Type: schema.TypeBool},
+		{Name: "unset", Path: "unset", Type: schema.TypeBool},
+		{Name: "count", Path: "count", Type: schema.TypeInt},
+		{Name: "enabled", Path: "enabled", Type: schema.TypeBool, Default: true},
+	}
+	block := map[string]interface{}{"explicit": false, "unset": false, "count": 0, "enabled": true}
+	rawConfig := cty.ObjectVal(map[string]cty.Value{
+		"details": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"explicit": cty.False,
+			"unset":    cty.NullVal(cty.Bool),
+			"count":    cty.NumberIntVal(0),
+			"enabled":  cty.NullVal(cty.Bool),
+		})}),
+	})
+
+	conf := &chronicle.RegisteredFeedConfiguration{Settings: map[string]interface{}{}, Configured: map[string]interface{}{}}
+	expandFeedFields(fields, block, rawConfig, "", conf)
+
+	expected := map[string]interface{}{"explicit": false, "count": 0, "enabled": true}
+	if !reflect.DeepEqual(conf.Settings, expected) {
+		t.Errorf("expected %v, got %v", expected, conf.Settings)
+	}
+
+	// feeds are expanded without configuration when read
+	conf = &chronicle.RegisteredFeedConfiguration{Settings: map[string]interface{}{}, Configured: map[string]interface{}{}}
+	expandFeedFields(fields, block, cty.NullVal(rawConfig.Type()), "", conf)
+
+	expected = map[string]interface{}{"enabled": true}
+	if !reflect.DeepEqual(conf.Settings, expected) {
+		t.Errorf("expected %v, got %v", expected, conf.Settings)
+	}
+}
+
+func TestRegisteredFeedResourceFlatten(t *testing.T) {
+	roleSettings := map[string]interface{}{
+		"queue":         "queue",
+		"region":        "EU_WEST_1",
+		"accountNumber": "123456789012",
+		"authentication": map[string]interface{}{
+			"awsIamRoleAuth": map[string]interface{}{"awsIamRoleArn": "arn:aws:iam::123456789012:role/chronicle", "subjectId": "subject"},
+		},
+	}
+	keySettings := map[string]interface{}{
+		"queue":                "queue",
+		"region":               "EU_WEST_1",
+		"accountNumber":        "123456789012",
+		"sourceDeletionOption": "SOURCE_DELETION_NEVER",
+		"authentication": map[string]interface{}{
+			"sqsAccessKeySecretAuth": map[string]interface{}{"accessKeyId": "id", "secretAccessKey": "secret"},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		original map[string]interface{}
+		read     map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "role authentication",
+			original: roleSettings,
+			read:     roleSettings,
+			expected: map[string]interface{}{
+				"queue":                 "queue",
+				"region":                "EU_WEST_1",
+				"account_number":        "123456789012",
+				"source_delete_options": "",
+				"role_authentication": []map[string]interface{}{{
+					"role_arn":   "arn:aws:iam::123456789012:role/chronicle",
+					"subject_id": "subject",
+				}},
+			},
+		},
+		{
+			name:     "secrets kept as configured",
+			original: keySettings,
+			read: map[string]interface{}{
+				"queue":          "queue",
+				"region":         "EU_WEST_1",
+				"accountNumber":  "123456789012",
+				"authentication": map[string]interface{}{},
+			},
+			expected: map[string]interface{}{
+				"queue":                 "queue",
+				"region":                "EU_WEST_1",
+				"account_number":        "123456789012",
+				"source_delete_options": "SOURCE_DELETION_NEVER",
+				"authentication": []map[string]interface{}{{
+					"sqs_access_key_id":     "id",
+					"sqs_secret_access_key": "secret",
+				}},
+			},
+		},
+		{
+			name: "import",
+			read: map[string]interface{}{
+				"queue":                "queue",
+				"region":               "EU_WEST_1",
+				"accountNumber":        "123456789012",
+				"sourceDeletionOption": "SOURCE_DELETION_NEVER",
+				"authentication": map[string]interface{}{
+					"sqsAccessKeySecretAuth": map[string]interface{}{"accessKeyId": "id"},
+				},
+			},
+			expected: map[string]interface{}{
+				"queue":                 "queue",
+				"region":                "EU_WEST_1",
+				"account_number":        "123456789012",
+				"source_delete_options": "SOURCE_DELETION_NEVER",
+				"authentication": []map[string]interface{}{{
+					"sqs_access_key_id":     "id",
+					"sqs_secret_access_key": "",
+				}},
+			},
+		},
+	}
+
+	sqs := &registeredFeedResource{spec: feedTypeAmazonSQS}
+	for _, c := range cases {
+		var original chronicle.ConcreteFeedConfiguration
+		if c.original != nil {
+			original = &chronicle.RegisteredFeedConfiguration{FeedType: chronicle.FeedTypeAmazonSQS, Settings: c.original}
+		}
+		read := &chronicle.RegisteredFeedConfiguration{FeedType: chronicle.FeedTypeAmazonSQS, Settings: c.read}
+
+		details := sqs.flattenDetailsFromReadOperation(original, read)
+		if !reflect.DeepEqual(details, []map[string]interface{}{c.expected}) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, details[0])
+		}
+	}
+}
+
+func TestRegisteredFeedResourceFlattenExpanded(t *testing.T) {
+	settings := map[string]interface{}{
+		"workspaceCustomerId": "C01234567",
+		"authentication": map[string]interface{}{
+			"claims": map[string]interface{}{"subject": "admin@example.com"},
+		},
+	}
+	original := &chronicle.RegisteredFeedConfiguration{
+		FeedType:   chronicle.FeedTypeGoogleWorkspaceUsers,
+		Settings:   settings,
+		Configured: map[string]interface{}{"authentication.0.service_account_json": testGoogleServiceAccountKey},
+	}
+	read := &chronicle.RegisteredFeedConfiguration{FeedType: chronicle.FeedTypeGoogleWorkspaceUsers, Settings: settings}
+
+	workspace := &registeredFeedResource{spec: feedTypeGoogleWorkspaceUsers}
+	for name, c := range map[string]struct {
+		original chronicle.ConcreteFeedConfiguration
+		key      string
+	}{
+		"kept as configured": {original: original, key: testGoogleServiceAccountKey},
+		"import":             {key: ""},
+	} {
+		expected := []map[string]interface{}{{
+			"customer_id": "C01234567",
+			"authentication": []map[string]interface{}{{
+				"admin_email":          "admin@example.com",
+				"service_account_json": c.key,
+			}},
+		}}
+		if details := workspace.flattenDetailsFromReadOperation(c.original, read); !reflect.DeepEqual(details, expected) {
+			t.Errorf("%s: expected %v, got %v", name, expected, details)
+		}
+	}
+}
+
+// feedTypeCase declares valid details of a feed type, the settings they expand into, and invalid values of its attributes,
+// by attribute path relative to details, e.g. authentication.0.client_id.
+type feedTypeCase struct {
+	spec     feedTypeSpec
+	details  map[string]interface{}
+	settings map[string]interface{}
+	invalid  map[string]interface{}
+}
+
+// TestFeedTypeCases checks that the details of every case validate, expand into its settings and flatten back unchanged
+// when read, and that every invalid value fails validation.
+func TestFeedTypeCases(t *testing.T) {
+	var cases []feedTypeCase
+	for _, family := range [][]feedTypeCase{googleWorkspaceFeedTypeCases(), microsoftGraphFeedTypeCases(), edrFeedTypeCases(), saasFeedTypeCases(), networkFeedTypeCases()} {
+		cases = append(cases, family...)
+	}
+
+	for _, c := range cases {
+		t.Run(c.spec.Name, func(t *testing.T) {
+			resource := newRegisteredFeedResource(c.spec)
+			if diags := resource.Validate(testFeedResourceConfig(c.spec, c.details)); diags.HasError() {
+				t.Fatalf("expected valid details, got %v", diags)
+			}
+
+			d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"details": []interface{}{c.details}})
+			feed := &registeredFeedResource{spec: c.spec}
+			conf := feed.expandConcreteFeedConfiguration(d).(*chronicle.RegisteredFeedConfiguration)
+			if !reflect.DeepEqual(conf.Settings, c.settings) {
+				t.Errorf("expected settings %v, got %v", c.settings, conf.Settings)
+			}
+
+			read := &chronicle.RegisteredFeedConfiguration{FeedType: conf.FeedType, Settings: conf.Settings}
+			flattened := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+			if err := flattened.Set("details", feed.flattenDetailsFromReadOperation(conf, read)); err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			if !reflect.DeepEqual(flattened.Get("details"), d.Get("details")) {
+				t.Errorf("expected details %v once read, got %v", d.Get("details"), flattened.Get("details"))
+			}
+
+			for path, value := range c.invalid {
+				details := withTestFeedDetail(c.details, strings.Split(path, "."), value)
+				if diags := resource.Validate(testFeedResourceConfig(c.spec, details)); !diags.HasError() {
+					t.Errorf("expected %s = %v to be invalid", path, value)
+				}
+			}
+		})
+	}
+}
+
+func testFeedResourceConfig(spec feedTypeSpec, details map[string]interface{}) *terraform.ResourceConfig {
+	config := map[string]interface{}{
+		"display_name": "test",
+		"enabled":      true,
+		"details":      []interface{}{details},
+	}
+	if spec.Type.LogType == "" {
+		config["log_type"] = "TEST"
+	}
+
+	return terraform.NewResourceConfigRaw(config)
+}
+
+// withTestFeedDetail returns a copy of details with value set at path, whose numeric segments index single blocks.
+func withTestFeedDetail(details map[string]interface{}, path []string, value interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(details)+1)
+	for k, v := range details {
+		copied[k] = v
+	}
+	if len(path) == 1 {
+		copied[path[0]] = value
+		return copied
+	}
+
+	nested, _ := copied[path[0]].([]interface{})
+	block := map[string]interface{}{}
+	if len(nested) > 0 {
+		block, _ = nested[0].(map[string]interface{})
+	}
+	copied[path[0]] = []interface{}{withTestFeedDetail(block, path[2:], value)}
+
+	return copied
+}
+
+func TestFeedSettings(t *testing.T) {
+	settings := map[string]interface{}{}
+	setFeedSetting(settings, "hostname", "example.com")
+	setFeedSetting(settings, "authentication.headerKeyValues.0.key", "Authorization")
+	setFeedSetting(settings, "authentication.headerKeyValues.0.value", "token")
+
+	expected := map[string]interface{}{
+		"hostname": "example.com",
+		"authentication": map[string]interface{}{
+			"headerKeyValues": []interface{}{map[string]interface{}{"key": "Authorization", "value": "token"}},
+		},
+	}
+	if !reflect.DeepEqual(settings, expected) {
+		t.Errorf("expected %v, got %v", expected, settings)
+	}
+
+	for path, value := range map[string]interface{}{
+		"hostname":                               "example.com",
+		"authentication.headerKeyValues.0.value": "token",
+		"authentication.headerKeyValues.1.value": nil,
+		"authentication.user":                    nil,
+		"hostname.missing":                       nil,
+	} {
+		if got := getFeedSetting(settings, path); got != value {
+			t.Errorf("%s: expected %v, got %v", path, value, got)
+		}
+	}
+}
+
+const testGoogleServiceAccountKey = `{"type": "service_account", "client_email": "feed@project.iam.gserviceaccount.com", "private_key": "key"}`
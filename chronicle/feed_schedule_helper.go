@@ -0,0 +1,117 @@
+package chronicle
+
+import (
+	"fmt"
+	"time"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// feedSchedulingSchema returns the attributes controlling when a pull feed fetches data.
+func feedSchedulingSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"schedule": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: `How often the feed fetches data. The default polling schedule of Chronicle is used when it is not set.`,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"interval": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: validateFeedScheduleInterval,
+						DiffSuppressFunc: suppressEquivalentDurationDiffs,
+						ExactlyOneOf:     []string{"schedule.0.interval", "schedule.0.cron"},
+						Description:      `Time between two runs of the feed, as a duration of at least a minute, e.g. 30m or 6h.`,
+					},
+					"cron": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: validateFeedScheduleCron,
+						ExactlyOneOf:     []string{"schedule.0.interval", "schedule.0.cron"},
+						Description:      `Cron expression, in UTC, of the runs of the feed, e.g. 0 */6 * * *.`,
+					},
+				},
+			},
+		},
+		"backfill_start_time": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateRFC3339Time,
+			DiffSuppressFunc: suppressEquivalentTimeDiffs,
+			Description: `Time, in RFC 3339 format, from which data is ingested when the feed first runs, e.g. to onboard
+			the last days of an archive. Data older than the default lookback of the feed is otherwise skipped.`,
+		},
+	}
+}
+
+func expandFeedScheduling(d *schema.ResourceData) chronicle.FeedScheduling {
+	scheduling := chronicle.FeedScheduling{
+		BackfillStartTime: readStringFromResource(d, "backfill_start_time"),
+	}
+
+	if schedule := readSliceFromResource(d, "schedule"); len(schedule) > 0 && schedule[0] != nil {
+		scheduleDetails := schedule[0].(map[string]interface{})
+		scheduling.Schedule = &chronicle.FeedSchedule{
+			CronExpression: scheduleDetails["cron"].(string),
+		}
+		if interval, err := time.ParseDuration(scheduleDetails["interval"].(string)); err == nil {
+			scheduling.Schedule.Interval = fmt.Sprintf("%ds", int64(interval.Seconds()))
+		}
+	}
+
+	return scheduling
+}
+
+// setFeedSchedulingProperties sets the scheduling of a feed as read, keeping configured values which are equivalent,
+// e.g. an interval of 1h read as 3600s.
+func setFeedSchedulingProperties(d *schema.ResourceData, scheduling chronicle.FeedScheduling) error {
+	var schedule []map[string]interface{}
+	if scheduling.Schedule != nil {
+		interval := scheduling.Schedule.Interval
+		if configured := readStringFromResource(d, "schedule.0.interval"); equivalentDurations(configured, interval) {
+			interval = configured
+		}
+		schedule = []map[string]interface{}{{
+			"interval": interval,
+			"cron":     scheduling.Schedule.CronExpression,
+		}}
+	}
+	if err := d.Set("schedule", schedule); err != nil {
+		return fmt.Errorf("error reading Schedule: %s", err)
+	}
+
+	backfillStartTime := scheduling.BackfillStartTime
+	if configured := readStringFromResource(d, "backfill_start_time"); equivalentTimes(configured, backfillStartTime) {
+		backfillStartTime = configured
+	}
+	if err := d.Set("backfill_start_time", backfillStartTime); err != nil {
+		return fmt.Errorf("error reading Backfill Start Time: %s", err)
+	}
+
+	return nil
+}
+
+func suppressEquivalentDurationDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return equivalentDurations(old, new)
+}
+
+func suppressEquivalentTimeDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return equivalentTimes(old, new)
+}
+
+func equivalentDurations(a, b string) bool {
+	durationA, errA := time.ParseDuration(a)
+	durationB, errB := time.ParseDuration(b)
+
+	return errA == nil && errB == nil && durationA == durationB
+}
+
+func equivalentTimes(a, b string) bool {
+	timeA, errA := time.Parse(time.RFC3339, a)
+	timeB, errB := time.Parse(time.RFC3339, b)
+
+	return errA == nil && errB == nil && timeA.Equal(timeB)
+}
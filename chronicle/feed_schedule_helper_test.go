@@ -0,0 +1,105 @@
+package chronicle
+
+import (
+	"reflect"
+	"testing"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandFeedScheduling(t *testing.T) {
+	cases := []struct {
+		config   map[string]interface{}
+		expected chronicle.FeedScheduling
+	}{
+		{
+			config:   map[string]interface{}{},
+			expected: chronicle.FeedScheduling{},
+		},
+		{
+			config: map[string]interface{}{
+				"schedule":            []interface{}{map[string]interface{}{"interval": "1h30m"}},
+				"backfill_start_time": "2024-01-01T00:00:00Z",
+			},
+			expected: chronicle.FeedScheduling{
+				Schedule:          &chronicle.FeedSchedule{Interval: "5400s"},
+				BackfillStartTime: "2024-01-01T00:00:00Z",
+			},
+		},
+		{
+			config: map[string]interface{}{
+				"schedule": []interface{}{map[string]interface{}{"cron": "0 */6 * * *"}},
+			},
+			expected: chronicle.FeedScheduling{
+				Schedule: &chronicle.FeedSchedule{CronExpression: "0 */6 * * *"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, feedSchedulingSchema(), c.config)
+		if scheduling := expandFeedScheduling(d); !reflect.DeepEqual(scheduling, c.expected) {
+			t.Errorf("expected %+v, got %+v", c.expected, scheduling)
+		}
+	}
+}
+
+func TestSetFeedSchedulingProperties(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, feedSchedulingSchema(), map[string]interface{}{
+		"schedule":            []interface{}{map[string]interface{}{"interval": "1h"}},
+		"backfill_start_time": "2024-01-01T01:00:00+01:00",
+	})
+
+	err := setFeedSchedulingProperties(d, chronicle.FeedScheduling{
+		Schedule:          &chronicle.FeedSchedule{Interval: "3600s"},
+		BackfillStartTime: "2024-01-01T00:00:00Z",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if interval := d.Get("schedule.0.interval"); interval != "1h" {
+		t.Errorf("expected the configured interval to be kept, got %v", interval)
+	}
+	if backfillStartTime := d.Get("backfill_start_time"); backfillStartTime != "2024-01-01T01:00:00+01:00" {
+		t.Errorf("expected the configured backfill start time to be kept, got %v", backfillStartTime)
+	}
+
+	err = setFeedSchedulingProperties(d, chronicle.FeedScheduling{
+		Schedule: &chronicle.FeedSchedule{Interval: "7200s"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if interval := d.Get("schedule.0.interval"); interval != "7200s" {
+		t.Errorf("expected the read interval, got %v", interval)
+	}
+	if backfillStartTime := d.Get("backfill_start_time"); backfillStartTime != "" {
+		t.Errorf("expected no backfill start time, got %v", backfillStartTime)
+	}
+}
+
+func TestValidateFeedScheduling(t *testing.T) {
+	cases := []struct {
+		validate schema.SchemaValidateDiagFunc
+		value    string
+		valid    bool
+	}{
+		{validateFeedScheduleInterval, "15m", true},
+		{validateFeedScheduleInterval, "3600s", true},
+		{validateFeedScheduleInterval, "30s", false},
+		{validateFeedScheduleInterval, "1m30.5s", false},
+		{validateFeedScheduleInterval, "daily", false},
+		{validateFeedScheduleCron, "0 */6 * * *", true},
+		{validateFeedScheduleCron, "0 */6 * *", false},
+		{validateRFC3339Time, "2024-01-01T00:00:00Z", true},
+		{validateRFC3339Time, "2024-01-01", false},
+	}
+
+	for _, c := range cases {
+		if diags := c.validate(c.value, cty.Path{}); diags.HasError() == c.valid {
+			t.Errorf("expected %q to be valid: %t, got %v", c.value, c.valid, diags)
+		}
+	}
+}
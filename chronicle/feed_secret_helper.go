@@ -0,0 +1,315 @@
+package chronicle
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// writeOnlySecretSuffix is appended to the name of a sensitive attribute to name its write-only alternative.
+const writeOnlySecretSuffix = "_wo"
+
+const feedSecretHashSaltLength = 16
+
+// addWriteOnlySecretAttributes adds a write-only alternative, e.g. secret_access_key_wo, next to every sensitive
+// attribute of a feed's details, including within single blocks. It returns the paths of the sensitive attributes,
+// e.g. details.0.authentication.0.secret_access_key.
+func addWriteOnlySecretAttributes(prefix string, resource *schema.Resource) []string {
+	keys := make([]string, 0, len(resource.Schema))
+	for k := range resource.Schema {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var paths []string
+	for _, k := range keys {
+		attribute := resource.Schema[k]
+		if nested, ok := attribute.Elem.(*schema.Resource); ok && attribute.Type == schema.TypeList && attribute.MaxItems == 1 {
+			paths = append(paths, addWriteOnlySecretAttributes(prefix+k+".0.", nested)...)
+			continue
+		}
+		if attribute.Type != schema.TypeString || !attribute.Sensitive || attribute.Computed {
+			continue
+		}
+
+		path := prefix + k
+		writeOnly := &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			WriteOnly:        true,
+			ValidateDiagFunc: attribute.ValidateDiagFunc,
+			ConflictsWith:    []string{path},
+			Description:      fmt.Sprintf("Write-only alternative to %s, which is never stored in state. Requires Terraform 1.11 or later.", k),
+		}
+		if attribute.Required {
+			attribute.Required = false
+			attribute.Optional = true
+			attribute.ExactlyOneOf = []string{path, path + writeOnlySecretSuffix}
+			writeOnly.ConflictsWith = nil
+			writeOnly.ExactlyOneOf = attribute.ExactlyOneOf
+		}
+		resource.Schema[k+writeOnlySecretSuffix] = writeOnly
+		paths = append(paths, path)
+	}
+
+	return paths
+}
+
+// addFeedSecretTracking tracks the secrets of a feed, as found at paths. Plain sensitive attributes are stored in state
+// like any other attribute, so Terraform diffs them as usual, but write-only secrets are not, so a salted hash of each of
+// them is stored instead to tell that they changed and have to be sent again. Secrets are also sent again whenever
+// rotate_secrets changes, and secret_version is incremented every time secrets are sent.
+// As the API never returns secrets, neither tells whether secrets were changed outside of Terraform.
+func addFeedSecretTracking(resource *schema.Resource, paths []string) {
+	resource.Schema["secret_hashes"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Description: "Salted SHA-256 hashes of the write-only secrets last sent to Chronicle, by attribute path. " +
+			"They are computed from the configuration, so they only tell that the configured secrets changed: " +
+			"secrets changed outside of Terraform are never detected, use rotate_secrets to send them again.",
+	}
+	resource.Schema["secret_version"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: `Incremented every time secrets are sent to Chronicle, e.g. to trigger dependent resources.`,
+	}
+	resource.Schema["rotate_secrets"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Description: `Arbitrary values which send the secrets to Chronicle again whenever they change, even if they are unchanged in the configuration,
+		e.g. to restore credentials rotated outside of Terraform.`,
+	}
+
+	create, update, customizeDiff := resource.Create, resource.Update, resource.CustomizeDiff
+	resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+		return withFeedSecrets(d, paths, create, meta)
+	}
+	resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+		return withFeedSecrets(d, paths, update, meta)
+	}
+	resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		return diffFeedSecrets(d, paths)
+	}
+}
+
+// withFeedSecrets calls operation with write-only secrets copied into their sensitive attribute, so that feeds can be expanded
+// as usual, and removes them once done so that they do not end up in state.
+func withFeedSecrets(d *schema.ResourceData, paths []string, operation func(*schema.ResourceData, interface{}) error, meta interface{}) error {
+	writeOnlySecrets := readWriteOnlyFeedSecrets(d.GetRawConfig(), paths)
+
+	oldHashes, _ := d.GetChange("secret_hashes")
+	hashes, err := hashFeedSecrets(writeOnlySecrets, oldHashes.(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+	if d.Id() == "" || feedSecretsChanged(d, paths, hashes, oldHashes.(map[string]interface{})) || d.HasChange("rotate_secrets") {
+		if err := d.Set("secret_hashes", hashes); err != nil {
+			return fmt.Errorf("error setting secret_hashes: %s", err)
+		}
+		oldVersion, _ := d.GetChange("secret_version")
+		if err := d.Set("secret_version", oldVersion.(int)+1); err != nil {
+			return fmt.Errorf("error setting secret_version: %s", err)
+		}
+	}
+
+	// Changes are told apart before write-only secrets are copied, which would otherwise show as changed.
+	secrets := map[string]string{}
+	for key, secret := range writeOnlySecrets {
+		secrets[strings.TrimSuffix(key, writeOnlySecretSuffix)] = secret
+	}
+	if err := setFeedDetailsValues(d, secrets); err != nil {
+		return err
+	}
+
+	err = operation(d, meta)
+
+	for path := range secrets {
+		secrets[path] = ""
+	}
+	if clearErr := setFeedDetailsValues(d, secrets); clearErr != nil && err == nil {
+		err = clearErr
+	}
+
+	return err
+}
+
+// diffFeedSecrets plans a new secret version when secrets changed, and new secret hashes when write-only secrets changed,
+// which they cannot show otherwise, or when rotate_secrets changed.
+func diffFeedSecrets(d *schema.ResourceDiff, paths []string) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	oldHashes, _ := d.GetChange("secret_hashes")
+	writeOnlySecrets := readWriteOnlyFeedSecrets(d.GetRawConfig(), paths)
+	unknown := false
+	for _, secret := range writeOnlySecrets {
+		unknown = unknown || secret == unknownFeedSecret
+	}
+	hashes, err := hashFeedSecrets(writeOnlySecrets, oldHashes.(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if unknown || d.HasChange("rotate_secrets") || feedSecretsChanged(d, paths, hashes, oldHashes.(map[string]interface{})) {
+		if err := d.SetNewComputed("secret_hashes"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("secret_version")
+	}
+
+	return nil
+}
+
+// feedSecretsChanged tells whether any plain sensitive attribute changed, or any write-only secret given its hashes.
+func feedSecretsChanged(d interface{ HasChange(string) bool }, paths []string, hashes, oldHashes map[string]interface{}) bool {
+	for _, path := range paths {
+		if d.HasChange(path) {
+			return true
+		}
+	}
+
+	return !equalFeedSecretHashes(hashes, oldHashes)
+}
+
+// unknownFeedSecret stands for secrets which are not known yet when planning.
+const unknownFeedSecret = "\x00unknown"
+
+// readWriteOnlyFeedSecrets returns the secrets configured through write-only attributes, by path of the write-only attribute.
+func readWriteOnlyFeedSecrets(rawConfig cty.Value, paths []string) map[string]string {
+	secrets := map[string]string{}
+	for _, path := range paths {
+		key := path + writeOnlySecretSuffix
+		value, err := feedCtyPath(key).Apply(rawConfig)
+		if err != nil || value.IsNull() {
+			continue
+		}
+		if !value.IsKnown() {
+			secrets[key] = unknownFeedSecret
+			continue
+		}
+		if value.AsString() != "" {
+			secrets[key] = value.AsString()
+		}
+	}
+
+	return secrets
+}
+
+// feedCtyPath turns a path such as details.0.authentication.0.secret_access_key into a cty.Path.
+func feedCtyPath(path string) cty.Path {
+	var ctyPath cty.Path
+	for _, step := range strings.Split(path, ".") {
+		if index, err := strconv.Atoi(step); err == nil {
+			ctyPath = ctyPath.IndexInt(index)
+		} else {
+			ctyPath = ctyPath.GetAttr(step)
+		}
+	}
+
+	return ctyPath
+}
+
+// hashFeedSecrets returns a salted hash of every secret, formatted as <salt>:<hash>.
+// Salts of oldHashes are reused so that unchanged secrets keep the same hash.
+func hashFeedSecrets(secrets map[string]string, oldHashes map[string]interface{}) (map[string]interface{}, error) {
+	hashes := map[string]interface{}{}
+	for key, secret := range secrets {
+		salt := ""
+		if oldHash, ok := oldHashes[key].(string); ok {
+			salt, _, _ = strings.Cut(oldHash, ":")
+		}
+		if salt == "" {
+			var err error
+			if salt, err = newFeedSecretHashSalt(); err != nil {
+				return nil, err
+			}
+		}
+		hashes[key] = hashFeedSecret(salt, secret)
+	}
+
+	return hashes, nil
+}
+
+func hashFeedSecret(salt, secret string) string {
+	hash := sha256.Sum256([]byte(salt + secret))
+	return salt + ":" + hex.EncodeToString(hash[:])
+}
+
+func newFeedSecretHashSalt() (string, error) {
+	salt := make([]byte, feedSecretHashSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("error generating secret hash salt: %s", err)
+	}
+
+	return hex.EncodeToString(salt), nil
+}
+
+func equalFeedSecretHashes(a, b map[string]interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// setFeedDetailsValues sets string attributes of the details block, by path.
+func setFeedDetailsValues(d *schema.ResourceData, values map[string]string) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	details, ok := d.Get("details").([]interface{})
+	if !ok || len(details) == 0 || details[0] == nil {
+		return nil
+	}
+	for path, value := range values {
+		setFeedDetailsValue(details, strings.Split(strings.TrimPrefix(path, "details."), "."), value)
+	}
+
+	if err := d.Set("details", details); err != nil {
+		return fmt.Errorf("error setting Details: %s", err)
+	}
+
+	return nil
+}
+
+func setFeedDetailsValue(list []interface{}, steps []string, value string) {
+	index, err := strconv.Atoi(steps[0])
+	if err != nil || index >= len(list) {
+		return
+	}
+	block, ok := list[index].(map[string]interface{})
+	if !ok {
+		return
+	}
+	if len(steps) == 2 {
+		block[steps[1]] = value
+		return
+	}
+	if nested, ok := block[steps[1]].([]interface{}); ok {
+		setFeedDetailsValue(nested, steps[2:], value)
+	}
+}
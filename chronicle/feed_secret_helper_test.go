@@ -0,0 +1,178 @@
+package chronicle
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAddWriteOnlySecretAttributes(t *testing.T) {
+	details := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"uri": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"authentication": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"secret": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"computed_secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+
+	paths := addWriteOnlySecretAttributes("details.0.", details)
+
+	expectedPaths := []string{"details.0.authentication.0.secret", "details.0.token"}
+	if !reflect.DeepEqual(paths, expectedPaths) {
+		t.Fatalf("expected paths %v, got %v", expectedPaths, paths)
+	}
+
+	authentication := details.Schema["authentication"].Elem.(*schema.Resource)
+	secret, secretWriteOnly := authentication.Schema["secret"], authentication.Schema["secret_wo"]
+	if secretWriteOnly == nil || !secretWriteOnly.WriteOnly || !secretWriteOnly.Sensitive {
+		t.Fatalf("expected secret_wo to be a sensitive write-only attribute, got %+v", secretWriteOnly)
+	}
+	if secret.Required || !secret.Optional {
+		t.Errorf("expected secret to be optional")
+	}
+	expectedExactlyOneOf := []string{"details.0.authentication.0.secret", "details.0.authentication.0.secret_wo"}
+	if !reflect.DeepEqual(secret.ExactlyOneOf, expectedExactlyOneOf) || !reflect.DeepEqual(secretWriteOnly.ExactlyOneOf, expectedExactlyOneOf) {
+		t.Errorf("expected secret and secret_wo to be exactly one of %v", expectedExactlyOneOf)
+	}
+
+	tokenWriteOnly := details.Schema["token_wo"]
+	if tokenWriteOnly == nil || !reflect.DeepEqual(tokenWriteOnly.ConflictsWith, []string{"details.0.token"}) {
+		t.Errorf("expected token_wo to conflict with token, got %+v", tokenWriteOnly)
+	}
+
+	for _, k := range []string{"uri_wo", "computed_secret_wo"} {
+		if _, ok := details.Schema[k]; ok {
+			t.Errorf("unexpected attribute %s", k)
+		}
+	}
+	if _, ok := authentication.Schema["user_wo"]; ok {
+		t.Errorf("unexpected attribute user_wo")
+	}
+}
+
+func TestReadWriteOnlyFeedSecrets(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"details": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"token":    cty.StringVal("plain"),
+			"token_wo": cty.NullVal(cty.String),
+			"authentication": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"secret":    cty.StringVal(""),
+				"secret_wo": cty.UnknownVal(cty.String),
+			})}),
+			"key":    cty.NullVal(cty.String),
+			"key_wo": cty.StringVal("write-only"),
+		})}),
+	})
+
+	secrets := readWriteOnlyFeedSecrets(config, []string{"details.0.authentication.0.secret", "details.0.token", "details.0.key", "details.0.missing"})
+
+	expected := map[string]string{
+		"details.0.authentication.0.secret_wo": unknownFeedSecret,
+		"details.0.key_wo":                     "write-only",
+	}
+	if !reflect.DeepEqual(secrets, expected) {
+		t.Errorf("expected %v, got %v", expected, secrets)
+	}
+}
+
+func TestHashFeedSecrets(t *testing.T) {
+	secrets := map[string]string{"details.0.token_wo": "secret"}
+
+	hashes, err := hashFeedSecrets(secrets, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, tc := range []struct {
+		name      string
+		secrets   map[string]string
+		oldHashes map[string]interface{}
+		equal     bool
+	}{
+		{name: "new salts for every hash", secrets: secrets},
+		{name: "unchanged secrets keep their hash", secrets: secrets, oldHashes: hashes, equal: true},
+		{name: "changed secrets change their hash", secrets: map[string]string{"details.0.token_wo": "other"}, oldHashes: hashes},
+	} {
+		got, err := hashFeedSecrets(tc.secrets, tc.oldHashes)
+		if err != nil {
+			t.Fatalf("%s: err: %s", tc.name, err)
+		}
+		if equalFeedSecretHashes(hashes, got) != tc.equal {
+			t.Errorf("%s: expected equal hashes to be %t", tc.name, tc.equal)
+		}
+	}
+}
+
+type feedSecretChanges map[string]bool
+
+func (c feedSecretChanges) HasChange(key string) bool {
+	return c[key]
+}
+
+func TestFeedSecretsChanged(t *testing.T) {
+	paths := []string{"details.0.token"}
+	hashes := map[string]interface{}{"details.0.token_wo": "salt:hash"}
+
+	if !feedSecretsChanged(feedSecretChanges{"details.0.token": true}, paths, nil, nil) {
+		t.Errorf("expected changed plain secrets to be detected")
+	}
+	if !feedSecretsChanged(feedSecretChanges{}, paths, hashes, nil) {
+		t.Errorf("expected changed write-only secrets to be detected")
+	}
+	if feedSecretsChanged(feedSecretChanges{}, paths, hashes, map[string]interface{}{"details.0.token_wo": "salt:hash"}) {
+		t.Errorf("expected unchanged write-only secrets not to be detected")
+	}
+}
+
+func TestSetFeedDetailsValue(t *testing.T) {
+	details := []interface{}{map[string]interface{}{
+		"token": "token",
+		"authentication": []interface{}{map[string]interface{}{
+			"secret": "secret",
+		}},
+	}}
+
+	setFeedDetailsValue(details, []string{"0", "authentication", "0", "secret"}, "")
+	setFeedDetailsValue(details, []string{"0", "token"}, "new")
+	setFeedDetailsValue(details, []string{"1", "token"}, "ignored")
+
+	expected := []interface{}{map[string]interface{}{
+		"token": "new",
+		"authentication": []interface{}{map[string]interface{}{
+			"secret": "",
+		}},
+	}}
+	if !reflect.DeepEqual(details, expected) {
+		t.Errorf("expected %v, got %v", expected, details)
+	}
+}
@@ -0,0 +1,167 @@
+package chronicle
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const feedRunPollInterval = 10 * time.Second
+
+// feedStatusSchema returns the computed attributes describing the last run of a feed.
+func feedStatusSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"failure_message": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `Message explaining why the last run of the feed failed, empty if it succeeded.`,
+		},
+		"failure_details": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: `Details of the failure of the last run of the feed.`,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"error_code": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: `Code of the error.`,
+					},
+					"http_error_code": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: `HTTP status code returned by the source, if any.`,
+					},
+					"error_cause": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: `Cause of the error.`,
+					},
+					"error_action": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: `Action suggested to fix the error.`,
+					},
+				},
+			},
+		},
+		"last_run_time": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `Time the last run of the feed started, in RFC 3339 format.`,
+		},
+		"last_successful_ingestion_time": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `Time data was last ingested successfully by the feed, in RFC 3339 format.`,
+		},
+	}
+}
+
+// waitForFirstRunSchema returns the attribute making create and update wait for the next run of a feed.
+func waitForFirstRunSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: `Wait, once the feed is created or updated, until its next run starts and is no longer in progress, and fail if
+		that run failed. Runs which started before the feed was updated are not waited for, nor are push feeds, which never run,
+		and disabled feeds. Waiting is bounded by the create and update timeouts.`,
+	}
+}
+
+func flattenFeedStatus(feed chronicle.BaseFeed) map[string]interface{} {
+	var failureDetails []map[string]interface{}
+	if feed.FailureDetails != nil {
+		failureDetails = []map[string]interface{}{{
+			"error_code":      feed.FailureDetails.ErrorCode,
+			"http_error_code": feed.FailureDetails.HTTPErrorCode,
+			"error_cause":     feed.FailureDetails.ErrorCause,
+			"error_action":    feed.FailureDetails.ErrorAction,
+		}}
+	}
+
+	return map[string]interface{}{
+		"failure_message":                feed.FailureMsg,
+		"failure_details":                failureDetails,
+		"last_run_time":                  feed.LastFeedInitiationTime,
+		"last_successful_ingestion_time": feed.LastSuccessfulIngestionTime,
+	}
+}
+
+func setFeedStatusProperties(d *schema.ResourceData, feed chronicle.BaseFeed) error {
+	for attribute, value := range flattenFeedStatus(feed) {
+		if err := d.Set(attribute, value); err != nil {
+			return fmt.Errorf("error reading %s: %s", attribute, err)
+		}
+	}
+
+	return nil
+}
+
+// feedFailureMessage describes the failure of a feed, falling back to its failure details when it has no message.
+func feedFailureMessage(feed chronicle.BaseFeed) string {
+	if feed.FailureMsg != "" {
+		return feed.FailureMsg
+	}
+	if feed.FailureDetails != nil {
+		return fmt.Sprintf("%s: %s", feed.FailureDetails.ErrorCode, feed.FailureDetails.ErrorCause)
+	}
+
+	return "no failure message"
+}
+
+// feedStateNotRun is the state of enabled feeds which have not run since they were created or updated.
+const feedStateNotRun = "NOT_RUN"
+
+// waitForFeedRun waits, if wait_for_first_run is set and the feed is enabled, until a run of the feed starts after since
+// and is no longer IN_PROGRESS, and fails when that run ends in FAILED. The state of previous runs is ignored, so that
+// a run which failed before an update does not fail it.
+func waitForFeedRun(d *schema.ResourceData, client *chronicle.Client, timeout time.Duration, since time.Time) error {
+	if !readBoolFromResource(d, "wait_for_first_run") || !readBoolFromResource(d, "enabled") {
+		return nil
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{FeedStateInProgress, feedStateNotRun},
+		Target:  []string{FeedStateActive, FeedStateInactive, FeedStateCompleted},
+		Refresh: func() (interface{}, string, error) {
+			feed, _, err := client.ReadGenericFeed(d.Id())
+			if err != nil {
+				return nil, "", err
+			}
+			if !isPushFeedSourceType(feed.Details.SourceType) && !feedRunStartedAfter(*feed, since) {
+				return feed, feedStateNotRun, nil
+			}
+			if feed.State == FeedStateFailed {
+				return nil, "", fmt.Errorf("feed %s failed: %s", d.Id(), feedFailureMessage(*feed))
+			}
+
+			return feed, feed.State, nil
+		},
+		Timeout:      timeout,
+		PollInterval: feedRunPollInterval,
+	}
+
+	log.Printf("[DEBUG] Waiting for the run of Feed %q", d.Id())
+	if _, err := stateConf.WaitForStateContext(context.Background()); err != nil {
+		return fmt.Errorf("error waiting for the run of Feed %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// feedRunStartedAfter tells whether the last run of a feed started after t.
+func feedRunStartedAfter(feed chronicle.BaseFeed, t time.Time) bool {
+	lastRunTime, err := time.Parse(time.RFC3339Nano, feed.LastFeedInitiationTime)
+	if err != nil {
+		return false
+	}
+
+	return lastRunTime.After(t)
+}
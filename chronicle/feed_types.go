@@ -0,0 +1,590 @@
+package chronicle
+
+import (
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+)
+
+const (
+	FeedS3SourceDeleteOptionDeletionNever              = "SOURCE_DELETION_NEVER"
+	FeedS3SourceDeleteOptionDeletionOnSuccess          = "SOURCE_DELETION_ON_SUCCESS"
+	FeedS3SourceDeleteOptionDeletionOnSuccessFilesOnly = "SOURCE_DELETION_ON_SUCCESS_FILES_ONLY"
+)
+
+const (
+	FeedS3SourceTypeFiles            = "FILES"
+	FeedS3SourceTypeFolders          = "FOLDERS"
+	FeedS3SourceTypeFoldersRecursive = "FOLDERS_RECURSIVE"
+)
+
+const (
+	FeedGoogleCloudStorageBucketSourceDeleteOptionDeletionNever              = "SOURCE_DELETION_NEVER"
+	FeedGoogleCloudStorageBucketSourceDeleteOptionDeletionOnSuccess          = "SOURCE_DELETION_ON_SUCCESS"
+	FeedGoogleCloudStorageBucketSourceDeleteOptionDeletionOnSuccessFilesOnly = "SOURCE_DELETION_ON_SUCCESS_FILES_ONLY"
+)
+
+const (
+	FeedGoogleCloudStorageBucketSourceTypeFiles            = "FILES"
+	FeedGoogleCloudStorageBucketSourceTypeFolders          = "FOLDERS"
+	FeedGoogleCloudStorageBucketSourceTypeFoldersRecursive = "FOLDERS_RECURSIVE"
+)
+
+const (
+	FeedAzureBlobStoreSourceTypeFiles            = "FILES"
+	FeedAzureBlobStoreSourceTypeFolders          = "FOLDERS"
+	FeedAzureBlobStoreSourceTypeFoldersRecursive = "FOLDERS_RECURSIVE"
+)
+
+const FeedAzureBlobStoreSourceDeleteOptionDeletionNever = "SOURCE_DELETION_NEVER"
+
+const (
+	FeedMicrosoftOffice365ManagementActivityContentTypeAuditAzureActiveDirectory = "AUDIT_AZURE_ACTIVE_DIRECTORY"
+	FeedMicrosoftOffice365ManagementActivityContentTypeAuditExchange             = "AUDIT_EXCHANGE"
+	FeedMicrosoftOffice365ManagementActivityContentTypeAuditSharePoint           = "AUDIT_SHARE_POINT"
+	FeedMicrosoftOffice365ManagementActivityContentTypeAuditGeneral              = "AUDIT_GENERAL"
+	FeedMicrosoftOffice365ManagementActivityContentTypeDPLAll                    = "DLP_ALL"
+)
+
+// feedTypeSpecs are the feed types whose resource is generated from their spec.
+var feedTypeSpecs = []feedTypeSpec{
+	feedTypeAmazonS3,
+	feedTypeAmazonSQS,
+	feedTypeGoogleCloudStorageBucket,
+	feedTypeAzureBlobStore,
+	feedTypeQualysVM,
+	feedTypeMicrosoftOffice365ManagementActivity,
+	feedTypeOktaSystemLog,
+	feedTypeOktaUsers,
+	feedTypeProofpointSIEM,
+	feedTypeThinkstCanary,
+	feedTypeGoogleWorkspaceActivity,
+	feedTypeGoogleWorkspaceUsers,
+	feedTypeGoogleWorkspaceGroups,
+	feedTypeGoogleWorkspaceAlerts,
+	feedTypeGoogleWorkspaceMobile,
+	feedTypeGoogleWorkspaceChromeOS,
+	feedTypeMicrosoftEntraIDSignIn,
+	feedTypeMicrosoftEntraIDAudit,
+	feedTypeMicrosoftEntraIDContext,
+	feedTypeMicrosoftGraphAlert,
+	feedTypeCrowdStrikeFalconDetections,
+	feedTypeCrowdStrikeFalconAlerts,
+	feedTypeSentinelOneAlerts,
+	feedTypeSentinelOneActivity,
+	feedTypeMicrosoftDefenderForEndpoint,
+	feedTypeSalesforce,
+	feedTypeDuoAdmin,
+	feedTypeSlackAudit,
+	feedTypeBox,
+	feedTypeWorkday,
+	feedTypeGitHubAudit,
+	feedTypeCloudflareAudit,
+	feedTypeZscaler,
+	feedTypeNetskope,
+	feedTypePaloAltoCortexDataLake,
+	feedTypeMimecast,
+}
+
+const feedFileSourceTypeDescription = `The type of file indicated by the uri. It may be the following:
+
+				- FILES: The URI points to a single file which will be ingested with each execution of the feed.
+				- FOLDERS: The URI points to a directory. All files contained within the directory will be ingested with each execution of the feed.
+				- FOLDERS_RECURSIVE: The URI points to a directory. All files and directories contains within the indicated directory will be ingested,
+				 including all files and directories within those directories, and so on. `
+
+const feedFileSourceDeleteOptionsDescription = `Whether to delete source files after they have been transferred to Chronicle. The possible values are as follows:
+
+				- SOURCE_DELETION_NEVER: Never delete files from the source.
+				- SOURCE_DELETION_ON_SUCCESS: Delete files and empty directories from the source after successful ingestion.
+				- SOURCE_DELETION_ON_SUCCESS_FILES_ONLY: Delete files from the source after successful ingestion.`
+
+const awsRegionDescription = `The region where the S3 bucket resides following this format: https://cloud.google.com/chronicle/docs/reference/feed-management-api#amazon_s3_regions.`
+
+var feedTypeAmazonS3 = feedTypeSpec{
+	Name:        "amazon_s3",
+	Type:        chronicle.FeedTypeAmazonS3,
+	Description: "Creates a feed from Amazon Simple Storage Service Bucket.",
+	Example:     "examples/resources/feed/amazon_s3/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:        "s3_uri",
+			Path:        "s3Uri",
+			Required:    true,
+			Description: `The S3 URI to ingest.`,
+		},
+		{
+			Name:        "s3_source_type",
+			Path:        "sourceType",
+			Required:    true,
+			Validate:    validateFeedS3SourceType,
+			Description: feedFileSourceTypeDescription,
+		},
+		{
+			Name:           "source_delete_options",
+			Path:           "sourceDeletionOption",
+			Required:       true,
+			Validate:       validateFeedS3SourceDeleteOption,
+			KeepConfigured: true,
+			Description:    feedFileSourceDeleteOptionsDescription,
+		},
+		{
+			Name:         "authentication",
+			Unless:       "authentication.awsIamRoleAuth",
+			ExactlyOneOf: []string{"details.0.authentication", "details.0.role_authentication"},
+			Description:  `AWS authentication details using an access key.`,
+			Fields: []feedFieldSpec{
+				amazonS3RegionField,
+				{
+					Name:           "access_key_id",
+					Path:           "authentication.accessKeyId",
+					Required:       true,
+					Validate:       validateAWSAccessKeyID,
+					KeepConfigured: true,
+					Description:    `This is the 20 character ID associated with your Amazon IAM account.`,
+				},
+				{
+					Name:        "secret_access_key",
+					Path:        "authentication.secretAccessKey",
+					Required:    true,
+					Sensitive:   true,
+					Validate:    validateAWSSecretAccessKey,
+					Description: `This is the 40 character access key associated with your Amazon IAM account.`,
+				},
+			},
+		},
+		{
+			Name:        "role_authentication",
+			When:        "authentication.awsIamRoleAuth",
+			Description: `AWS authentication details using an IAM role, as an alternative to access keys.`,
+			Fields:      append(awsIAMRoleAuthenticationFields("authentication.awsIamRoleAuth"), amazonS3RegionField),
+		},
+	},
+}
+
+var amazonS3RegionField = feedFieldSpec{
+	Name:           "region",
+	Path:           "authentication.region",
+	Required:       true,
+	KeepConfigured: true,
+	Description:    awsRegionDescription,
+}
+
+// awsIAMRoleAuthenticationFields returns the fields authenticating AWS feeds with an IAM role, found at path in the settings.
+func awsIAMRoleAuthenticationFields(path string) []feedFieldSpec {
+	return []feedFieldSpec{
+		{
+			Name:           "role_arn",
+			Path:           path + ".awsIamRoleArn",
+			Required:       true,
+			Validate:       validateAWSIAMRoleARN,
+			KeepConfigured: true,
+			Description: `The ARN of the AWS IAM role Chronicle assumes through identity federation. The role must trust accounts.google.com,
+			with a condition on accounts.google.com:sub matching subject_id.`,
+		},
+		{
+			Name:        "subject_id",
+			Path:        path + ".subjectId",
+			Computed:    true,
+			Description: `The ID of the Chronicle service account assuming the role, to be used in the trust policy of the role.`,
+		},
+	}
+}
+
+var feedTypeAmazonSQS = feedTypeSpec{
+	Name:        "amazon_sqs",
+	Type:        chronicle.FeedTypeAmazonSQS,
+	Description: "Creates a feed from Amazon Simple Queue Service.",
+	Example:     "examples/resources/feed/amazon_sqs/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:        "queue",
+			Path:        "queue",
+			Required:    true,
+			Description: `The SQS queue name.`,
+		},
+		{
+			Name:        "region",
+			Path:        "region",
+			Required:    true,
+			Description: awsRegionDescription,
+		},
+		{
+			Name:        "account_number",
+			Path:        "accountNumber",
+			Required:    true,
+			Validate:    validateAWSAccountID,
+			Description: `The account number for the SQS queue and S3 bucket.`,
+		},
+		{
+			Name:           "source_delete_options",
+			Path:           "sourceDeletionOption",
+			Required:       true,
+			Validate:       validateFeedS3SourceDeleteOption,
+			KeepConfigured: true,
+			Description: `Whether to delete the source files in the S3 bucket after they have been transferred to Chronicle. This reduces storage costs. Valid values are:
+
+				- SOURCE_DELETION_NEVER: Never delete files from the source.
+				- SOURCE_DELETION_ON_SUCCESS: Delete files and empty directories from the source after successful ingestion.
+				- SOURCE_DELETION_ON_SUCCESS_FILES_ONLY: Delete files from the source after successful ingestion.`,
+		},
+		{
+			Name:         "authentication",
+			Unless:       "authentication.awsIamRoleAuth",
+			ExactlyOneOf: []string{"details.0.authentication", "details.0.role_authentication"},
+			Description:  `AWS authentication details using access keys.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:           "sqs_access_key_id",
+					Path:           "authentication.sqsAccessKeySecretAuth.accessKeyId",
+					Required:       true,
+					Validate:       validateAWSAccessKeyID,
+					KeepConfigured: true,
+					Description:    `This is the 20 character ID associated with your Amazon IAM account.`,
+				},
+				{
+					Name:        "sqs_secret_access_key",
+					Path:        "authentication.sqsAccessKeySecretAuth.secretAccessKey",
+					Required:    true,
+					Sensitive:   true,
+					Validate:    validateAWSSecretAccessKey,
+					Description: `This is the 40 character access key associated with your Amazon IAM account.`,
+				},
+				{
+					Name:        "s3_authentication",
+					When:        "authentication.additionalS3AccessKeySecretAuth",
+					Description: `S3 AWS authentication details. Only specify if using a different access key for the S3 bucket.`,
+					Fields: []feedFieldSpec{
+						{
+							Name:           "access_key_id",
+							Path:           "authentication.additionalS3AccessKeySecretAuth.accessKeyId",
+							Required:       true,
+							Validate:       validateAWSAccessKeyID,
+							KeepConfigured: true,
+							Description:    `This is the 20 character ID associated with your Amazon IAM account.`,
+						},
+						{
+							Name:        "secret_access_key",
+							Path:        "authentication.additionalS3AccessKeySecretAuth.secretAccessKey",
+							Required:    true,
+							Sensitive:   true,
+							Validate:    validateAWSSecretAccessKey,
+							Description: `This is the 40 character access key associated with your Amazon IAM account.`,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "role_authentication",
+			When:        "authentication.awsIamRoleAuth",
+			Description: `AWS authentication details using an IAM role allowed to access both the SQS queue and the S3 bucket, as an alternative to access keys.`,
+			Fields:      awsIAMRoleAuthenticationFields("authentication.awsIamRoleAuth"),
+		},
+	},
+}
+
+var feedTypeGoogleCloudStorageBucket = feedTypeSpec{
+	Name:        "google_cloud_storage_bucket",
+	Type:        chronicle.FeedTypeGoogleCloudStorageBucket,
+	Description: "Creates a feed from Google Cloud Storage Bucket service.",
+	Example:     "examples/resources/feed/google_cloud_storage_bucket/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:        "bucket_uri",
+			Path:        "bucketUri",
+			Required:    true,
+			Validate:    validateGCSURI,
+			Description: `The bucket URI to ingest.`,
+		},
+		{
+			Name:        "bucket_source_type",
+			Path:        "sourceType",
+			Required:    true,
+			Validate:    validateFeedGCSSourceType,
+			Description: feedFileSourceTypeDescription,
+		},
+		{
+			Name:        "source_delete_options",
+			Path:        "sourceDeletionOption",
+			Required:    true,
+			Validate:    validateFeedGCSSourceDeleteOption,
+			Description: feedFileSourceDeleteOptionsDescription,
+		},
+	},
+}
+
+var feedTypeAzureBlobStore = feedTypeSpec{
+	Name:        "azure_blobstore",
+	Type:        chronicle.FeedTypeAzureBlobStore,
+	Description: "Creates a feed from Azure Blobstore service.",
+	Example:     "examples/resources/feed/azure_blobstore/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:        "uri",
+			Path:        "azureUri",
+			Required:    true,
+			Description: `The URI pointing to a Azure Blob Storage blob or container.`,
+		},
+		{
+			Name:        "source_type",
+			Path:        "sourceType",
+			Required:    true,
+			Validate:    validateFeedAzureBlobStoreSourceType,
+			Description: feedFileSourceTypeDescription,
+		},
+		{
+			Name:     "source_delete_options",
+			Path:     "sourceDeletionOption",
+			Default:  FeedAzureBlobStoreSourceDeleteOptionDeletionNever,
+			Validate: validateFeedAzureBlobStoreSourceDeleteOption,
+			Description: `Whether to delete source files after they have been transferred to Chronicle. The possible values are as follows:
+
+				- SOURCE_DELETION_NEVER: Never delete files from the source.`,
+		},
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `Azure authentication details.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:        "shared_key",
+					Path:        "authentication.sharedKey",
+					Sensitive:   true,
+					Description: `A shared key, a 512-bit random string in base64 encoding, authorized to access Azure Blob Storage. Required if not specifying an SAS Token.`,
+				},
+				{
+					Name:        "sas_token",
+					Path:        "authentication.sasToken",
+					Sensitive:   true,
+					Description: `A Shared Access Signature authorized to access the Azure Blob Storage container.`,
+				},
+			},
+		},
+	},
+}
+
+var feedTypeQualysVM = feedTypeSpec{
+	Name:        "qualys_vm",
+	Type:        chronicle.FeedTypeQualysVM,
+	Description: "Creates a feed from API source Type for Qualys VM log type.",
+	Example:     "examples/resources/feed/api/qualys_vm/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:           "hostname",
+			Path:           "hostname",
+			Required:       true,
+			KeepConfigured: true,
+			Description:    `Qualys VM hostname.`,
+		},
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `AWS authentication details.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:           "user",
+					Path:           "authentication.user",
+					Required:       true,
+					KeepConfigured: true,
+					Description:    `Username.`,
+				},
+				{
+					Name:        "secret",
+					Path:        "authentication.secret",
+					Required:    true,
+					Sensitive:   true,
+					Description: `Password.`,
+				},
+			},
+		},
+	},
+}
+
+var feedTypeMicrosoftOffice365ManagementActivity = feedTypeSpec{
+	Name:        "microsoft_office_365_management_activity",
+	Type:        chronicle.FeedTypeMicrosoftOffice365ManagementActivity,
+	Description: "Creates a feed from API source type for Microsoft Office 365 Management Activity log type.",
+	Example:     "examples/resources/feed/api/microsoft_office_365_management_activity/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			// the API strips the path out of the hostname
+			Name:           "hostname",
+			Path:           "hostname",
+			KeepConfigured: true,
+			Description:    `API Full Path, default value: manage.office.com/api/v1.0.`,
+		},
+		microsoftTenantIDField,
+		{
+			Name:        "content_type",
+			Path:        "contentType",
+			Required:    true,
+			Validate:    validateFeedMicrosoftOffice365ManagementActivityContentType,
+			Description: `The type of logs to fetch. See https://cloud.google.com/chronicle/docs/reference/feed-management-api#office_365_content_type.`,
+		},
+		microsoftAuthenticationField(`Office 365 authentication details.`),
+	},
+}
+
+var microsoftTenantIDField = feedFieldSpec{
+	Name:        "tenant_id",
+	Path:        "tenantId",
+	Required:    true,
+	Validate:    validateUUID,
+	Description: `Tenant ID (a UUID).`,
+}
+
+// microsoftAuthenticationField returns the block authenticating Microsoft feeds with the client credentials of an application
+// registered in the tenant.
+func microsoftAuthenticationField(description string) feedFieldSpec {
+	return feedFieldSpec{
+		Name:        "authentication",
+		Required:    true,
+		Description: description,
+		Fields: []feedFieldSpec{
+			{
+				Name:           "client_id",
+				Path:           "authentication.clientId",
+				Required:       true,
+				Validate:       validateUUID,
+				KeepConfigured: true,
+				Description:    `OAuth client ID (a UUID).`,
+			},
+			{
+				Name:        "client_secret",
+				Path:        "authentication.clientSecret",
+				Required:    true,
+				Sensitive:   true,
+				Description: `OAuth client secret.`,
+			},
+		},
+	}
+}
+
+// oktaAuthenticationField is the authentication header of Okta feeds.
+var oktaAuthenticationField = feedFieldSpec{
+	Name:        "authentication",
+	Required:    true,
+	Description: `Okta authentication header details.`,
+	Fields: []feedFieldSpec{
+		{
+			Name:           "key",
+			Path:           "authentication.headerKeyValues.0.key",
+			Required:       true,
+			KeepConfigured: true,
+			Description:    `Okta authorization key.`,
+		},
+		{
+			Name:        "value",
+			Path:        "authentication.headerKeyValues.0.value",
+			Required:    true,
+			Sensitive:   true,
+			Description: `Okta API token.`,
+		},
+	},
+}
+
+var feedTypeOktaSystemLog = feedTypeSpec{
+	Name:        "okta_system_log",
+	Type:        chronicle.FeedTypeOktaSystemLog,
+	Description: "Creates a feed from API source Type for Okta System Log log type.",
+	Example:     "examples/resources/feed/api/okta_system_log/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:        "hostname",
+			Path:        "hostname",
+			Required:    true,
+			Description: `Okta hostname.`,
+		},
+		oktaAuthenticationField,
+	},
+}
+
+var feedTypeOktaUsers = feedTypeSpec{
+	Name:        "okta_users",
+	Type:        chronicle.FeedTypeOktaUsers,
+	Description: "Creates a feed from API source Type for Okta Users log type.",
+	Example:     "examples/resources/feed/api/okta_users/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:           "hostname",
+			Path:           "hostname",
+			Required:       true,
+			KeepConfigured: true,
+			Description:    `Okta hostname.`,
+		},
+		{
+			Name:           "manager_id",
+			Path:           "managerIdReferenceField",
+			KeepConfigured: true,
+			Description: `Manager ID is required when you use a non-Okta ID to reference managers.
+				It should be a JSON field path pointing to the field that contains the manager ID in the result of a call to the "users" Okta API.`,
+		},
+		oktaAuthenticationField,
+	},
+}
+
+var feedTypeProofpointSIEM = feedTypeSpec{
+	Name:        "proofpoint_siem",
+	Type:        chronicle.FeedTypeProofpointSIEM,
+	Description: "Creates a feed from API source type for Proofpoint SIEM log type.",
+	Example:     "examples/resources/feed/api/proofpoint_siem/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `Proofpoint authentication header details.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:           "user",
+					Path:           "authentication.user",
+					Required:       true,
+					KeepConfigured: true,
+					Description:    `Proofpoint user.`,
+				},
+				{
+					Name:        "secret",
+					Path:        "authentication.secret",
+					Required:    true,
+					Sensitive:   true,
+					Description: `Proofpoint secret.`,
+				},
+			},
+		},
+	},
+}
+
+var feedTypeThinkstCanary = feedTypeSpec{
+	Name:        "thinkst_canary",
+	Type:        chronicle.FeedTypeThinkstCanary,
+	Description: "Creates a feed from API source Type for Thinkst Canary log type.",
+	Example:     "examples/resources/feed/api/thinkst_canary/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:        "hostname",
+			Path:        "hostname",
+			Required:    true,
+			Validate:    validateThinkstCanaryHostname,
+			Description: `Thinkst Canary hostname.`,
+		},
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `Thinkst Canary authentication details.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:           "key",
+					Path:           "authentication.headerKeyValues.0.key",
+					Default:        "auth_token",
+					KeepConfigured: true,
+					Description:    `Thinkst Canary authentication key. Defaults to auth_token.`,
+				},
+				{
+					Name:        "value",
+					Path:        "authentication.headerKeyValues.0.value",
+					Required:    true,
+					Sensitive:   true,
+					Description: `Thinkst Canary authentication value.`,
+				},
+			},
+		},
+	},
+}
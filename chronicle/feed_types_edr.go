@@ -0,0 +1,121 @@
+package chronicle
+
+import (
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+)
+
+var feedTypeCrowdStrikeFalconDetections = feedTypeSpec{
+	Name:        "crowdstrike_falcon_detections",
+	Type:        chronicle.FeedTypeCrowdStrikeFalconDetections,
+	Description: "Creates a feed from API source type for CrowdStrike Falcon detections log type.",
+	Example:     "examples/resources/feed/api/crowdstrike_falcon_detections/main.tf",
+	Fields:      crowdStrikeFalconFields(),
+}
+
+var feedTypeCrowdStrikeFalconAlerts = feedTypeSpec{
+	Name:        "crowdstrike_falcon_alerts",
+	Type:        chronicle.FeedTypeCrowdStrikeFalconAlerts,
+	Description: "Creates a feed from API source type for CrowdStrike Falcon alerts log type.",
+	Example:     "examples/resources/feed/api/crowdstrike_falcon_alerts/main.tf",
+	Fields:      crowdStrikeFalconFields(),
+}
+
+// crowdStrikeFalconFields returns the fields of CrowdStrike Falcon feeds, which authenticate with the client credentials
+// of an API client of the Falcon console.
+func crowdStrikeFalconFields() []feedFieldSpec {
+	return []feedFieldSpec{
+		{
+			Name:     "hostname",
+			Path:     "hostname",
+			Required: true,
+			Validate: validateCrowdStrikeFalconHostname,
+			Description: `API hostname of the Falcon cloud region: api.crowdstrike.com (US-1), api.us-2.crowdstrike.com (US-2),
+			api.eu-1.crowdstrike.com (EU-1) or api.laggar.gcw.crowdstrike.com (US-GOV-1).`,
+		},
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `CrowdStrike Falcon authentication details.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:           "client_id",
+					Path:           "authentication.clientId",
+					Required:       true,
+					KeepConfigured: true,
+					Description:    `OAuth client ID of the API client.`,
+				},
+				{
+					Name:        "client_secret",
+					Path:        "authentication.clientSecret",
+					Required:    true,
+					Sensitive:   true,
+					Description: `OAuth client secret of the API client.`,
+				},
+			},
+		},
+	}
+}
+
+var feedTypeSentinelOneAlerts = feedTypeSpec{
+	Name:        "sentinelone_alerts",
+	Type:        chronicle.FeedTypeSentinelOneAlerts,
+	Description: "Creates a feed from API source type for SentinelOne alerts log type.",
+	Example:     "examples/resources/feed/api/sentinelone_alerts/main.tf",
+	Fields:      sentinelOneFields(),
+}
+
+var feedTypeSentinelOneActivity = feedTypeSpec{
+	Name:        "sentinelone_activity",
+	Type:        chronicle.FeedTypeSentinelOneActivity,
+	Description: "Creates a feed from API source type for SentinelOne activity log type.",
+	Example:     "examples/resources/feed/api/sentinelone_activity/main.tf",
+	Fields:      sentinelOneFields(),
+}
+
+// sentinelOneFields returns the fields of SentinelOne feeds, which fetch from the management console of the account.
+func sentinelOneFields() []feedFieldSpec {
+	return []feedFieldSpec{
+		{
+			Name:        "hostname",
+			Path:        "hostname",
+			Required:    true,
+			Validate:    validateSentinelOneHostname,
+			Description: `Hostname of the SentinelOne management console, e.g. example.sentinelone.net.`,
+		},
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `SentinelOne authentication details.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:        "api_token",
+					Path:        "authentication.apiToken",
+					Required:    true,
+					Sensitive:   true,
+					Description: `API token of a service user of the management console.`,
+				},
+			},
+		},
+	}
+}
+
+var feedTypeMicrosoftDefenderForEndpoint = feedTypeSpec{
+	Name:        "microsoft_defender_for_endpoint",
+	Type:        chronicle.FeedTypeMicrosoftDefenderForEndpoint,
+	Description: "Creates a feed from API source type for Microsoft Defender for Endpoint log type.",
+	Example:     "examples/resources/feed/api/microsoft_defender_for_endpoint/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			// the API fills in the default hostname
+			Name:           "hostname",
+			Path:           "hostname",
+			Validate:       validateMicrosoftDefenderHostname,
+			KeepConfigured: true,
+			Description: `API hostname, default value: api.securitycenter.microsoft.com. Regional hostnames such as api-eu.securitycenter.microsoft.com
+			may be used, and tenants of sovereign clouds use api-gcc.securitycenter.microsoft.us or api-gov.securitycenter.microsoft.us.`,
+		},
+		microsoftAuthEndpointField,
+		microsoftTenantIDField,
+		microsoftAuthenticationField(`Defender for Endpoint authentication details.`),
+	},
+}
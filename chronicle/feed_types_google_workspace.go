@@ -0,0 +1,128 @@
+package chronicle
+
+import (
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// googleOAuthTokenURI is the token endpoint of service account keys which do not set their own.
+const googleOAuthTokenURI = "https://oauth2.googleapis.com/token"
+
+// feedGoogleWorkspaceActivityApplications are the applications of the Admin SDK Reports API whose activity can be fetched.
+var feedGoogleWorkspaceActivityApplications = []string{
+	"access_transparency", "admin", "calendar", "chat", "chrome", "context_aware_access", "data_studio", "drive", "gcp", "gplus",
+	"groups", "groups_enterprise", "jamboard", "keep", "login", "meet", "mobile", "rules", "saml", "token", "user_accounts", "vault",
+}
+
+var feedTypeGoogleWorkspaceActivity = feedTypeSpec{
+	Name:        "google_workspace_activity",
+	Type:        chronicle.FeedTypeGoogleWorkspaceActivity,
+	Description: "Creates a feed from API source type for Google Workspace Activity log type.",
+	Example:     "examples/resources/feed/api/google_workspace_activity/main.tf",
+	Fields: append(googleWorkspaceFields(), feedFieldSpec{
+		Name:     "applications",
+		Path:     "applications",
+		Type:     schema.TypeList,
+		Required: true,
+		Validate: validateFeedGoogleWorkspaceActivityApplication,
+		Description: `Applications whose activity is fetched, e.g. login or admin.
+				See https://developers.google.com/admin-sdk/reports/reference/rest/v1/activities/list#applicationname.`,
+	}),
+}
+
+var feedTypeGoogleWorkspaceUsers = feedTypeSpec{
+	Name:        "google_workspace_users",
+	Type:        chronicle.FeedTypeGoogleWorkspaceUsers,
+	Description: "Creates a feed from API source type for Google Workspace Users log type.",
+	Example:     "examples/resources/feed/api/google_workspace_users/main.tf",
+	Fields:      googleWorkspaceFields(),
+}
+
+var feedTypeGoogleWorkspaceGroups = feedTypeSpec{
+	Name:        "google_workspace_groups",
+	Type:        chronicle.FeedTypeGoogleWorkspaceGroups,
+	Description: "Creates a feed from API source type for Google Workspace Groups log type.",
+	Example:     "examples/resources/feed/api/google_workspace_groups/main.tf",
+	Fields:      googleWorkspaceFields(),
+}
+
+var feedTypeGoogleWorkspaceAlerts = feedTypeSpec{
+	Name:        "google_workspace_alerts",
+	Type:        chronicle.FeedTypeGoogleWorkspaceAlerts,
+	Description: "Creates a feed from API source type for Google Workspace Alerts log type.",
+	Example:     "examples/resources/feed/api/google_workspace_alerts/main.tf",
+	Fields:      googleWorkspaceFields(),
+}
+
+var feedTypeGoogleWorkspaceMobile = feedTypeSpec{
+	Name:        "google_workspace_mobile",
+	Type:        chronicle.FeedTypeGoogleWorkspaceMobile,
+	Description: "Creates a feed from API source type for Google Workspace Mobile devices log type.",
+	Example:     "examples/resources/feed/api/google_workspace_mobile/main.tf",
+	Fields:      googleWorkspaceFields(),
+}
+
+var feedTypeGoogleWorkspaceChromeOS = feedTypeSpec{
+	Name:        "google_workspace_chromeos",
+	Type:        chronicle.FeedTypeGoogleWorkspaceChromeOS,
+	Description: "Creates a feed from API source type for Google Workspace ChromeOS devices log type.",
+	Example:     "examples/resources/feed/api/google_workspace_chromeos/main.tf",
+	Fields:      googleWorkspaceFields(),
+}
+
+// googleWorkspaceFields returns the fields shared by Google Workspace feeds, which fetch the data of a customer
+// through a service account with domain-wide delegation impersonating an administrator.
+func googleWorkspaceFields() []feedFieldSpec {
+	return []feedFieldSpec{
+		{
+			Name:        "customer_id",
+			Path:        "workspaceCustomerId",
+			Required:    true,
+			Validate:    validateGoogleWorkspaceCustomerID,
+			Description: `Google Workspace customer ID, e.g. C01234567, found in the account settings of the Admin console.`,
+		},
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `Google Workspace authentication details, using a service account with domain-wide delegation.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:           "admin_email",
+					Path:           "authentication.claims.subject",
+					Required:       true,
+					Validate:       validateEmail,
+					KeepConfigured: true,
+					Description:    `Email of the Google Workspace administrator impersonated by the service account.`,
+				},
+				{
+					Name:        "service_account_json",
+					Required:    true,
+					Sensitive:   true,
+					Validate:    validateGoogleServiceAccountKey,
+					Expand:      expandGoogleServiceAccountKey,
+					Description: `JSON key of the service account, which must be granted domain-wide delegation of the read-only scopes of the fetched data.`,
+				},
+			},
+		},
+	}
+}
+
+// expandGoogleServiceAccountKey returns the settings authenticating a feed with a service account key: the JWT claims
+// and the private key signing them.
+func expandGoogleServiceAccountKey(value interface{}) map[string]interface{} {
+	key, err := parseGoogleServiceAccountKey(value.(string))
+	if err != nil {
+		return nil
+	}
+	tokenURI := key.TokenURI
+	if tokenURI == "" {
+		tokenURI = googleOAuthTokenURI
+	}
+
+	return map[string]interface{}{
+		"authentication.tokenEndpoint":            tokenURI,
+		"authentication.claims.issuer":            key.ClientEmail,
+		"authentication.claims.audience":          tokenURI,
+		"authentication.rsCredentials.privateKey": key.PrivateKey,
+	}
+}
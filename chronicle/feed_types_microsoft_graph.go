@@ -0,0 +1,79 @@
+package chronicle
+
+import (
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var feedTypeMicrosoftEntraIDSignIn = feedTypeSpec{
+	Name:        "microsoft_entra_id_sign_in",
+	Type:        chronicle.FeedTypeMicrosoftEntraIDSignIn,
+	Description: "Creates a feed from API source type for Microsoft Entra ID (Azure AD) sign-in log type.",
+	Example:     "examples/resources/feed/api/microsoft_entra_id_sign_in/main.tf",
+	Fields:      microsoftGraphFields(`Entra ID authentication details.`),
+}
+
+var feedTypeMicrosoftEntraIDAudit = feedTypeSpec{
+	Name:        "microsoft_entra_id_audit",
+	Type:        chronicle.FeedTypeMicrosoftEntraIDAudit,
+	Description: "Creates a feed from API source type for Microsoft Entra ID (Azure AD) audit log type.",
+	Example:     "examples/resources/feed/api/microsoft_entra_id_audit/main.tf",
+	Fields:      microsoftGraphFields(`Entra ID authentication details.`),
+}
+
+var feedTypeMicrosoftEntraIDContext = feedTypeSpec{
+	Name:        "microsoft_entra_id_context",
+	Type:        chronicle.FeedTypeMicrosoftEntraIDContext,
+	Description: "Creates a feed from API source type for Microsoft Entra ID (Azure AD) organizational context log type.",
+	Example:     "examples/resources/feed/api/microsoft_entra_id_context/main.tf",
+	Fields: append(microsoftGraphFields(`Entra ID authentication details.`),
+		feedFieldSpec{
+			Name:        "retrieve_devices",
+			Path:        "retrieveDevices",
+			Type:        schema.TypeBool,
+			Description: `Whether to fetch the devices of the users.`,
+		},
+		feedFieldSpec{
+			Name:        "retrieve_groups",
+			Path:        "retrieveGroups",
+			Type:        schema.TypeBool,
+			Description: `Whether to fetch the groups of the users.`,
+		},
+	),
+}
+
+var feedTypeMicrosoftGraphAlert = feedTypeSpec{
+	Name:        "microsoft_graph_alert",
+	Type:        chronicle.FeedTypeMicrosoftGraphAlert,
+	Description: "Creates a feed from API source type for Microsoft Graph security alerts log type.",
+	Example:     "examples/resources/feed/api/microsoft_graph_alert/main.tf",
+	Fields:      microsoftGraphFields(`Microsoft Graph authentication details.`),
+}
+
+// microsoftGraphFields returns the fields of feeds fetching from the Microsoft Graph API. The hostnames are only set
+// for tenants of sovereign clouds.
+func microsoftGraphFields(authenticationDescription string) []feedFieldSpec {
+	return []feedFieldSpec{
+		{
+			// the API fills in the default hostname
+			Name:           "hostname",
+			Path:           "hostname",
+			Validate:       validateMicrosoftGraphHostname,
+			KeepConfigured: true,
+			Description: `API Full Path, default value: graph.microsoft.com/v1.0. Tenants of sovereign clouds use
+				graph.microsoft.us/v1.0 (GCC High), dod-graph.microsoft.us/v1.0 (DoD) or microsoftgraph.chinacloudapi.cn/v1.0 (China).`,
+		},
+		microsoftAuthEndpointField,
+		microsoftTenantIDField,
+		microsoftAuthenticationField(authenticationDescription),
+	}
+}
+
+var microsoftAuthEndpointField = feedFieldSpec{
+	Name:           "auth_endpoint",
+	Path:           "authEndpoint",
+	Validate:       validateMicrosoftLoginHostname,
+	KeepConfigured: true,
+	Description: `OAuth endpoint, default value: login.microsoftonline.com. Tenants of sovereign clouds use
+			login.microsoftonline.us (GCC High and DoD) or login.chinacloudapi.cn (China).`,
+}
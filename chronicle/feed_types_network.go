@@ -0,0 +1,248 @@
+package chronicle
+
+import (
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// feedZscalerCloudNames are the Zscaler clouds an organization may be provisioned on.
+var feedZscalerCloudNames = []string{
+	"zscaler", "zscalerone", "zscalertwo", "zscalerthree", "zscloud", "zscalerbeta", "zscalergov", "zscalerten",
+}
+
+// FeedNetskopeContentTypeAlerts is the only content type supported, as the feed ingests the NETSKOPE_ALERT_V2 log type.
+const FeedNetskopeContentTypeAlerts = "alerts"
+
+// feedNetskopeAlertTypes are the categories of alerts exported by the Netskope REST API v2.
+var feedNetskopeAlertTypes = []string{
+	"compromisedcredential", "ctep", "device", "dlp", "malsite", "malware", "policy", "quarantine", "remediation",
+	"securityassessment", "uba", "watchlist",
+}
+
+// feedCortexDataLakeRegions are the regions a Cortex Data Lake instance may be deployed in.
+var feedCortexDataLakeRegions = []string{"americas", "europe", "uk", "singapore", "canada", "japan", "australia", "germany", "india", "switzerland", "france"}
+
+// feedMimecastBaseURLs are the API base URLs of the Mimecast regions.
+var feedMimecastBaseURLs = []string{
+	"eu-api.mimecast.com", "de-api.mimecast.com", "us-api.mimecast.com", "usb-api.mimecast.com", "ca-api.mimecast.com",
+	"za-api.mimecast.com", "au-api.mimecast.com", "je-api.mimecast.com",
+}
+
+var feedTypeCloudflareAudit = feedTypeSpec{
+	Name:        "cloudflare_audit",
+	Type:        chronicle.FeedTypeCloudflareAudit,
+	Description: "Creates a feed from API source type for Cloudflare audit log type.",
+	Example:     "examples/resources/feed/api/cloudflare_audit/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:        "account_id",
+			Path:        "accountId",
+			Required:    true,
+			Validate:    validateCloudflareAccountID,
+			Description: `ID of the Cloudflare account whose audit log is fetched, found in the overview of the dashboard.`,
+		},
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `Cloudflare authentication details.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:        "token",
+					Path:        "authentication.token",
+					Required:    true,
+					Sensitive:   true,
+					Description: `API token with the Account Settings Read permission on the account.`,
+				},
+			},
+		},
+	},
+}
+
+var feedTypeZscaler = feedTypeSpec{
+	Name:        "zscaler",
+	Type:        chronicle.FeedTypeZscaler,
+	Description: "Creates a feed from API source type for Zscaler Internet Access admin audit log type.",
+	Example:     "examples/resources/feed/api/zscaler/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:        "cloud_name",
+			Path:        "cloudName",
+			Required:    true,
+			Validate:    validateFeedZscalerCloudName,
+			Description: `Zscaler cloud of the organization, e.g. zscalertwo. See https://config.zscaler.com.`,
+		},
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `Zscaler authentication details.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:        "api_key",
+					Path:        "authentication.apiKey",
+					Required:    true,
+					Sensitive:   true,
+					Description: `API key of the organization, found in the Administration section of the ZIA Admin Portal.`,
+				},
+				{
+					Name:           "user",
+					Path:           "authentication.user",
+					Required:       true,
+					KeepConfigured: true,
+					Description:    `Username of an administrator with API access.`,
+				},
+				{
+					Name:        "secret",
+					Path:        "authentication.secret",
+					Required:    true,
+					Sensitive:   true,
+					Description: `Password of the administrator.`,
+				},
+			},
+		},
+	},
+}
+
+var feedTypeNetskope = feedTypeSpec{
+	Name:        "netskope",
+	Type:        chronicle.FeedTypeNetskope,
+	Description: "Creates a feed from API source type for Netskope alerts log type, using the REST API v2.",
+	Example:     "examples/resources/feed/api/netskope/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:        "hostname",
+			Path:        "hostname",
+			Required:    true,
+			Validate:    validateNetskopeHostname,
+			Description: `Hostname of the Netskope tenant, e.g. example.goskope.com.`,
+		},
+		{
+			Name:     "content_type",
+			Path:     "contentType",
+			Default:  FeedNetskopeContentTypeAlerts,
+			Validate: validateFeedNetskopeContentType,
+			Description: `The type of data to fetch. It may be the following:
+
+				- alerts: Alerts of the types listed in content_categories.`,
+		},
+		{
+			Name:     "content_categories",
+			Path:     "contentCategories",
+			Type:     schema.TypeList,
+			Required: true,
+			Validate: validateFeedNetskopeContentCategory,
+			Description: `Alert types, e.g. dlp or malware, to fetch.
+				See https://docs.netskope.com/en/rest-api-v2-overview-312207.html.`,
+		},
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `Netskope authentication details.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:        "token",
+					Path:        "authentication.token",
+					Required:    true,
+					Sensitive:   true,
+					Description: `REST API v2 token, granted read access to the endpoints of the fetched content categories.`,
+				},
+			},
+		},
+	},
+}
+
+var feedTypePaloAltoCortexDataLake = feedTypeSpec{
+	Name:        "palo_alto_cortex_data_lake",
+	Type:        chronicle.FeedTypePaloAltoCortexDataLake,
+	Description: "Creates a feed from API source type for Palo Alto Networks Cortex Data Lake log type.",
+	Example:     "examples/resources/feed/api/palo_alto_cortex_data_lake/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:        "region",
+			Path:        "region",
+			Required:    true,
+			Validate:    validateFeedCortexDataLakeRegion,
+			Description: `Region of the Cortex Data Lake instance, e.g. americas or europe.`,
+		},
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `Cortex Data Lake authentication details, using an app registered in the Cortex hub.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:           "client_id",
+					Path:           "authentication.clientId",
+					Required:       true,
+					KeepConfigured: true,
+					Description:    `OAuth client ID of the app.`,
+				},
+				{
+					Name:        "client_secret",
+					Path:        "authentication.clientSecret",
+					Required:    true,
+					Sensitive:   true,
+					Description: `OAuth client secret of the app.`,
+				},
+				{
+					Name:        "refresh_token",
+					Path:        "authentication.refreshToken",
+					Required:    true,
+					Sensitive:   true,
+					Description: `OAuth refresh token of the app, granted the logging-service:read scope on the instance.`,
+				},
+			},
+		},
+	},
+}
+
+var feedTypeMimecast = feedTypeSpec{
+	Name:        "mimecast",
+	Type:        chronicle.FeedTypeMimecast,
+	Description: "Creates a feed from API source type for Mimecast mail log type.",
+	Example:     "examples/resources/feed/api/mimecast/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:     "base_url",
+			Path:     "hostname",
+			Required: true,
+			Validate: validateFeedMimecastBaseURL,
+			Description: `API base URL of the region of the Mimecast account, e.g. eu-api.mimecast.com.
+				See https://integrations.mimecast.com/documentation/api-overview/global-base-urls.`,
+		},
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `Mimecast authentication details.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:           "application_id",
+					Path:           "authentication.applicationId",
+					Required:       true,
+					Validate:       validateUUID,
+					KeepConfigured: true,
+					Description:    `ID of the API application (a UUID).`,
+				},
+				{
+					Name:        "application_key",
+					Path:        "authentication.applicationKey",
+					Required:    true,
+					Sensitive:   true,
+					Description: `Key of the API application.`,
+				},
+				{
+					Name:        "access_key",
+					Path:        "authentication.accessKey",
+					Required:    true,
+					Sensitive:   true,
+					Description: `Access key of the user the application acts as.`,
+				},
+				{
+					Name:        "secret_key",
+					Path:        "authentication.secretKey",
+					Required:    true,
+					Sensitive:   true,
+					Description: `Secret key of the user the application acts as.`,
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,226 @@
+package chronicle
+
+import (
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+)
+
+var feedTypeSalesforce = feedTypeSpec{
+	Name:        "salesforce",
+	Type:        chronicle.FeedTypeSalesforce,
+	Description: "Creates a feed from API source type for Salesforce log type.",
+	Example:     "examples/resources/feed/api/salesforce/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:        "hostname",
+			Path:        "hostname",
+			Required:    true,
+			Validate:    validateSalesforceHostname,
+			Description: `Hostname of the My Domain of the Salesforce org, e.g. example.my.salesforce.com.`,
+		},
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `Salesforce authentication details, using the OAuth 2.0 JWT bearer flow of a connected app.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:           "client_id",
+					Path:           "authentication.clientId",
+					Required:       true,
+					KeepConfigured: true,
+					Description:    `Consumer key of the connected app.`,
+				},
+				{
+					Name:           "user",
+					Path:           "authentication.user",
+					Required:       true,
+					KeepConfigured: true,
+					Description:    `Username of the Salesforce user the feed acts as, pre-authorized in the connected app.`,
+				},
+				{
+					Name:        "private_key",
+					Path:        "authentication.rsCredentials.privateKey",
+					Required:    true,
+					Sensitive:   true,
+					Description: `PEM encoded private key signing the JWT, whose certificate is uploaded to the connected app.`,
+				},
+			},
+		},
+	},
+}
+
+var feedTypeDuoAdmin = feedTypeSpec{
+	Name:        "duo_admin",
+	Type:        chronicle.FeedTypeDuoAdmin,
+	Description: "Creates a feed from API source type for Duo Admin log type.",
+	Example:     "examples/resources/feed/api/duo_admin/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:        "hostname",
+			Path:        "hostname",
+			Required:    true,
+			Validate:    validateDuoAdminHostname,
+			Description: `API hostname of the Admin API application, e.g. api-XXXXXXXX.duosecurity.com.`,
+		},
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `Duo Admin API authentication details.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:           "integration_key",
+					Path:           "authentication.user",
+					Required:       true,
+					KeepConfigured: true,
+					Description:    `Integration key of the Admin API application.`,
+				},
+				{
+					Name:        "secret_key",
+					Path:        "authentication.secret",
+					Required:    true,
+					Sensitive:   true,
+					Description: `Secret key of the Admin API application.`,
+				},
+			},
+		},
+	},
+}
+
+var feedTypeSlackAudit = feedTypeSpec{
+	Name:        "slack_audit",
+	Type:        chronicle.FeedTypeSlackAudit,
+	Description: "Creates a feed from API source type for Slack audit log type.",
+	Example:     "examples/resources/feed/api/slack_audit/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `Slack authentication details.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:        "oauth_token",
+					Path:        "authentication.token",
+					Required:    true,
+					Sensitive:   true,
+					Description: `User OAuth token of a Slack app installed on the Enterprise Grid organization, with the auditlogs:read scope.`,
+				},
+			},
+		},
+	},
+}
+
+var feedTypeBox = feedTypeSpec{
+	Name:        "box",
+	Type:        chronicle.FeedTypeBox,
+	Description: "Creates a feed from API source type for Box log type.",
+	Example:     "examples/resources/feed/api/box/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `Box authentication details, using a Custom App with server authentication (JWT).`,
+			Fields: []feedFieldSpec{
+				{
+					Name:        "app_config_json",
+					Required:    true,
+					Sensitive:   true,
+					Validate:    validateBoxAppConfig,
+					Expand:      expandBoxAppConfig,
+					Description: `JSON configuration of the app, as downloaded from the Box developer console, including its private key.`,
+				},
+			},
+		},
+	},
+}
+
+// expandBoxAppConfig returns the settings authenticating a feed with the configuration of a Box app.
+func expandBoxAppConfig(value interface{}) map[string]interface{} {
+	config, err := parseBoxAppConfig(value.(string))
+	if err != nil {
+		return nil
+	}
+
+	settings := map[string]interface{}{
+		"authentication.clientId":     config.BoxAppSettings.ClientID,
+		"authentication.clientSecret": config.BoxAppSettings.ClientSecret,
+		"authentication.publicKeyId":  config.BoxAppSettings.AppAuth.PublicKeyID,
+		"authentication.privateKey":   config.BoxAppSettings.AppAuth.PrivateKey,
+		"authentication.enterpriseId": config.EnterpriseID,
+	}
+	if config.BoxAppSettings.AppAuth.Passphrase != "" {
+		settings["authentication.passphrase"] = config.BoxAppSettings.AppAuth.Passphrase
+	}
+
+	return settings
+}
+
+var feedTypeWorkday = feedTypeSpec{
+	Name:        "workday",
+	Type:        chronicle.FeedTypeWorkday,
+	Description: "Creates a feed from API source type for Workday log type.",
+	Example:     "examples/resources/feed/api/workday/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:        "hostname",
+			Path:        "hostname",
+			Required:    true,
+			Validate:    validateWorkdayHostname,
+			Description: `Hostname of the web services of the Workday tenant, e.g. wd5-services1.myworkday.com.`,
+		},
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `Workday authentication details.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:           "user",
+					Path:           "authentication.user",
+					Required:       true,
+					KeepConfigured: true,
+					Description:    `Username of the integration system user.`,
+				},
+				{
+					Name:        "secret",
+					Path:        "authentication.secret",
+					Required:    true,
+					Sensitive:   true,
+					Description: `Password of the integration system user.`,
+				},
+			},
+		},
+	},
+}
+
+var feedTypeGitHubAudit = feedTypeSpec{
+	Name:        "github_audit",
+	Type:        chronicle.FeedTypeGitHubAudit,
+	Description: "Creates a feed from API source type for GitHub audit log type.",
+	Example:     "examples/resources/feed/api/github_audit/main.tf",
+	Fields: []feedFieldSpec{
+		{
+			Name:         "enterprise",
+			Path:         "enterprise",
+			ExactlyOneOf: []string{"details.0.enterprise", "details.0.organization"},
+			Description:  `Slug of the enterprise whose audit log is fetched.`,
+		},
+		{
+			Name:         "organization",
+			Path:         "organization",
+			ExactlyOneOf: []string{"details.0.enterprise", "details.0.organization"},
+			Description:  `Name of the organization whose audit log is fetched.`,
+		},
+		{
+			Name:        "authentication",
+			Required:    true,
+			Description: `GitHub authentication details.`,
+			Fields: []feedFieldSpec{
+				{
+					Name:        "token",
+					Path:        "authentication.token",
+					Required:    true,
+					Sensitive:   true,
+					Description: `Personal access token of an owner of the enterprise or organization, with the read:audit_log scope.`,
+				},
+			},
+		},
+	},
+}
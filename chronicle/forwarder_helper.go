@@ -0,0 +1,113 @@
+package chronicle
+
+import (
+	"sort"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ForwarderRegexFilterBehaviorAllow = "ALLOW"
+	ForwarderRegexFilterBehaviorBlock = "BLOCK"
+
+	ForwarderStateActive    = "ACTIVE"
+	ForwarderStateSuspended = "SUSPENDED"
+)
+
+// forwarderRegexFilterSchema is shared by forwarders and collectors, a collector filter applies on top of the forwarder ones.
+func forwarderRegexFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: `Regular expression filters applied to the log lines before they are sent to Chronicle.`,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"description": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: `Description of the filter.`,
+				},
+				"regexp": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: `Regular expression matched against each log line.`,
+				},
+				"behavior": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validateForwarderRegexFilterBehavior,
+					Description:      `What to do with the matching lines, ALLOW or BLOCK.`,
+				},
+			},
+		},
+	}
+}
+
+func expandForwarderMetadata(d *schema.ResourceData) *chronicle.ForwarderMetadata {
+	namespace := readStringFromResource(d, "namespace")
+	labels := extractLabelsFromFeedResource(d)
+	if namespace == "" && len(labels) == 0 {
+		return nil
+	}
+
+	return &chronicle.ForwarderMetadata{
+		AssetNamespace: namespace,
+		Labels:         labels,
+	}
+}
+
+func flattenForwarderMetadata(d *schema.ResourceData, metadata *chronicle.ForwarderMetadata) error {
+	if metadata == nil {
+		metadata = &chronicle.ForwarderMetadata{}
+	}
+
+	if err := d.Set("namespace", metadata.AssetNamespace); err != nil {
+		return err
+	}
+
+	return d.Set("labels", extractLabelMapFromFeedLabels(metadata.Labels))
+}
+
+func expandForwarderRegexFilters(d *schema.ResourceData) []chronicle.ForwarderRegexFilter {
+	filtersRaw := readSliceFromResource(d, "regex_filter")
+	filters := make([]chronicle.ForwarderRegexFilter, 0, len(filtersRaw))
+	for _, f := range filtersRaw {
+		filter := f.(map[string]interface{})
+		filters = append(filters, chronicle.ForwarderRegexFilter{
+			Description: filter["description"].(string),
+			Regexp:      filter["regexp"].(string),
+			Behavior:    filter["behavior"].(string),
+		})
+	}
+
+	return filters
+}
+
+func flattenForwarderRegexFilters(filters []chronicle.ForwarderRegexFilter) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(filters))
+	for _, filter := range filters {
+		result = append(result, map[string]interface{}{
+			"description": filter.Description,
+			"regexp":      filter.Regexp,
+			"behavior":    filter.Behavior,
+		})
+	}
+
+	return result
+}
+
+// forwarderUpdateMask maps the changed attributes to the API field paths expected in update_mask.
+func forwarderUpdateMask(d *schema.ResourceData, fields map[string]string) []string {
+	mask := make([]string, 0, len(fields))
+	seen := make(map[string]bool)
+	for attribute, field := range fields {
+		if d.HasChange(attribute) && !seen[field] {
+			mask = append(mask, field)
+			seen[field] = true
+		}
+	}
+	sort.Strings(mask)
+
+	return mask
+}
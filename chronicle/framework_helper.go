@@ -0,0 +1,60 @@
+package chronicle
+
+import (
+	"context"
+	"fmt"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// frameworkClient returns the client shared by the providers from the provider data of a resource, nil until the
+// provider is configured.
+func frameworkClient(providerData interface{}, diags *diag.Diagnostics) *chronicle.Client {
+	if providerData == nil {
+		return nil
+	}
+
+	client, ok := providerData.(*chronicle.Client)
+	if !ok {
+		diags.AddError("error configuring resource", fmt.Sprintf("expected *client.Client, got %T", providerData))
+		return nil
+	}
+
+	return client
+}
+
+// sdkStringValidator runs a validation function of SDKv2 attributes, so that resources migrated to the plugin framework
+// are validated as before.
+type sdkStringValidator struct {
+	validate    schema.SchemaValidateDiagFunc
+	description string
+}
+
+var _ validator.String = sdkStringValidator{}
+
+func (v sdkStringValidator) Description(ctx context.Context) string {
+	return v.description
+}
+
+func (v sdkStringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.description
+}
+
+func (v sdkStringValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, d := range v.validate(req.ConfigValue.ValueString(), cty.GetAttrPath(req.Path.String())) {
+		if d.Severity == sdkdiag.Error {
+			resp.Diagnostics.AddAttributeError(req.Path, d.Summary, d.Detail)
+		} else {
+			resp.Diagnostics.AddAttributeWarning(req.Path, d.Summary, d.Detail)
+		}
+	}
+}
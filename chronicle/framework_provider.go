@@ -0,0 +1,111 @@
+package chronicle
+
+import (
+	"context"
+	"fmt"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/form3tech-oss/terraform-provider-chronicle/version"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// frameworkProvider serves the resources migrated to the plugin framework. It is muxed with the SDKv2 provider, which
+// is configured first and whose client it shares, so that both providers are configured the same way.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+var _ provider.Provider = &frameworkProvider{}
+
+// NewFrameworkProvider returns the plugin framework provider to be muxed with sdkProvider.
+func NewFrameworkProvider(sdkProvider *schema.Provider) provider.Provider {
+	return &frameworkProvider{sdkProvider: sdkProvider}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "chronicle"
+	resp.Version = version.ProviderVersion
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	attributes, err := frameworkProviderAttributes(p.sdkProvider.Schema)
+	if err != nil {
+		resp.Diagnostics.AddError("error converting provider schema", err.Error())
+		return
+	}
+
+	resp.Schema = providerschema.Schema{Attributes: attributes}
+}
+
+// frameworkProviderAttributes converts the schema of the SDKv2 provider, which must be identical across muxed providers.
+// The configuration is validated by the SDKv2 provider only.
+func frameworkProviderAttributes(sdkSchema map[string]*schema.Schema) (map[string]providerschema.Attribute, error) {
+	attributes := make(map[string]providerschema.Attribute, len(sdkSchema))
+	for name, attribute := range sdkSchema {
+		switch attribute.Type {
+		case schema.TypeString:
+			attributes[name] = providerschema.StringAttribute{
+				Required:    attribute.Required,
+				Optional:    attribute.Optional,
+				Sensitive:   attribute.Sensitive,
+				Description: attribute.Description,
+			}
+		case schema.TypeInt:
+			attributes[name] = providerschema.Int64Attribute{
+				Required:    attribute.Required,
+				Optional:    attribute.Optional,
+				Sensitive:   attribute.Sensitive,
+				Description: attribute.Description,
+			}
+		case schema.TypeBool:
+			attributes[name] = providerschema.BoolAttribute{
+				Required:    attribute.Required,
+				Optional:    attribute.Optional,
+				Sensitive:   attribute.Sensitive,
+				Description: attribute.Description,
+			}
+		case schema.TypeList:
+			if elem, ok := attribute.Elem.(*schema.Schema); !ok || elem.Type != schema.TypeString {
+				return nil, fmt.Errorf("provider attribute %s: only lists of strings are supported", name)
+			}
+			attributes[name] = providerschema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    attribute.Required,
+				Optional:    attribute.Optional,
+				Sensitive:   attribute.Sensitive,
+				Description: attribute.Description,
+			}
+		default:
+			return nil, fmt.Errorf("provider attribute %s: type %s is not supported", name, attribute.Type)
+		}
+	}
+
+	return attributes, nil
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	client, ok := p.sdkProvider.Meta().(*chronicle.Client)
+	if !ok {
+		resp.Diagnostics.AddError("error configuring provider", "the SDKv2 provider must be configured before the plugin framework provider")
+		return
+	}
+
+	resp.DataSourceData = client
+	resp.ResourceData = client
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		newRuleResource,
+		newReferenceListResource,
+	}
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
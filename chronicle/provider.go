@@ -3,6 +3,7 @@ package chronicle
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
@@ -23,6 +24,62 @@ func Provider() *schema.Provider {
 				}, chronicle.RegionEurope),
 				Description: fmt.Sprintf(`Region to which send requests, available regions are: %v. It may be replaced by CHRONICLE_REGION environment variable.`, chronicle.Regions),
 			},
+			"base_url_template": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateBaseURLTemplate,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					chronicle.BaseURLTemplateEnvVar,
+				}, chronicle.DefaultBaseURLTemplate),
+				Description: fmt.Sprintf(`Template every API base URL is resolved from, defaults to %q. "{subdomain}" is replaced by the regional subdomain
+				 of each API (e.g. "europe-west2-backstory") and "{region}" by the region. Custom endpoints take precedence over it.
+				 It may be replaced by CHRONICLE_BASE_URL_TEMPLATE environment variable.`, chronicle.DefaultBaseURLTemplate),
+			},
+
+			"api_version": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateAPIVersion,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					chronicle.APIVersionEnvVar,
+				}, chronicle.APIVersionLegacy),
+				Description: fmt.Sprintf(`API version to which send requests, available versions are: %v. "legacy" targets the Backstory APIs,
+				 while any other version targets the instance-scoped Chronicle API of Google SecOps and requires "project", "location" and "instance_id".
+				 It may be replaced by CHRONICLE_API_VERSION environment variable.`, chronicle.APIVersions),
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					chronicle.ProjectEnvVar,
+				}, nil),
+				Description: `Google Cloud project the Google SecOps instance is bound to. It may be replaced by CHRONICLE_PROJECT environment variable.`,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					chronicle.LocationEnvVar,
+				}, nil),
+				Description: `Location of the Google SecOps instance, e.g. "us" or "eu". It may be replaced by CHRONICLE_LOCATION environment variable.`,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					chronicle.InstanceIDEnvVar,
+				}, nil),
+				Description: `ID (customer ID) of the Google SecOps instance. It may be replaced by CHRONICLE_INSTANCE_ID environment variable.`,
+			},
+			"customer_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateUUID,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					chronicle.CustomerIDEnvVar,
+				}, nil),
+				Description: `Chronicle customer ID, required to send data through the Ingestion API. It may be replaced by CHRONICLE_CUSTOMER_ID environment variable.`,
+			},
 
 			"bigqueryapi_credentials": {
 				Type:             schema.TypeString,
@@ -84,6 +141,26 @@ func Provider() *schema.Provider {
 				Description:   `Forwarder API Access token. Local file path or content.`,
 			},
 
+			"impersonate_service_account": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					chronicle.ImpersonateServiceAccountEnvVar,
+				}, nil),
+				Description: `Email of the service account to impersonate for every API. The configured credentials,
+				 or Application Default Credentials when none are configured, must be granted roles/iam.serviceAccountTokenCreator on it.
+				 It may be replaced by CHRONICLE_IMPERSONATE_SERVICE_ACCOUNT environment variable.`,
+			},
+			"impersonate_service_account_delegates": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `Delegation chain of service accounts used to impersonate "impersonate_service_account".
+				 Each service account must be granted roles/iam.serviceAccountTokenCreator on the next one in the chain.`,
+			},
+
 			"request_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -96,117 +173,49 @@ func Provider() *schema.Provider {
 				Description: `Number of attempts per request. Attempts follow exponential back-off strategy. Defaults to 5 attempts.`,
 				Default:     5,
 			},
-
-			"events_custom_endpoint": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Description:      `Custom URL to events endpoint.`,
-				ValidateDiagFunc: validateCustomEndpoint,
-				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
-					"CHRONICLE_EVENTS_CUSTOM_ENDPOINT",
-				}, nil),
-			},
-
-			"alert_custom_endpoint": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Description:      `Custom URL to alert endpoint.`,
-				ValidateDiagFunc: validateCustomEndpoint,
-				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
-					"CHRONICLE_ALERT_CUSTOM_ENDPOINT",
-				}, nil),
-			},
-
-			"artifact_custom_endpoint": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Description:      `Custom URL to artifact endpoint.`,
-				ValidateDiagFunc: validateCustomEndpoint,
-				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
-					"CHRONICLE_ARTIFACT_CUSTOM_ENDPOINT",
-				}, nil),
-			},
-
-			"alias_custom_endpoint": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Description:      `Custom URL to alias endpoint.`,
-				ValidateDiagFunc: validateCustomEndpoint,
-				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
-					"CHRONICLE_ALIAS_CUSTOM_ENDPOINT",
-				}, nil),
-			},
-
-			"asset_custom_endpoint": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Description:      `Custom URL to asset endpoint.`,
-				ValidateDiagFunc: validateCustomEndpoint,
-				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
-					"CHRONICLE_ASSET_CUSTOM_ENDPOINT",
-				}, nil),
-			},
-
-			"ioc_custom_endpoint": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Description:      `Custom URL to ioc endpoint.`,
-				ValidateDiagFunc: validateCustomEndpoint,
+			"validate_log_types": {
+				Type:     schema.TypeBool,
+				Optional: true,
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
-					"CHRONICLE_IOC_CUSTOM_ENDPOINT",
-				}, nil),
-			},
-
-			"rule_custom_endpoint": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Description:      `Custom URL to rule endpoint.`,
-				ValidateDiagFunc: validateCustomEndpoint,
-				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
-					"CHRONICLE_RULE_CUSTOM_ENDPOINT",
-				}, nil),
-			},
-
-			"feed_custom_endpoint": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Description:      `Custom URL to feed endpoint.`,
-				ValidateDiagFunc: validateCustomEndpoint,
-				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
-					"CHRONICLE_FEED_CUSTOM_ENDPOINT",
-				}, nil),
-			},
-
-			"subjects_custom_endpoint": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Description:      `Custom URL to subjects endpoint.`,
-				ValidateDiagFunc: validateCustomEndpoint,
-				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
-					"CHRONICLE_SUBJECTS_CUSTOM_ENDPOINT",
-				}, nil),
+					chronicle.ValidateLogTypesEnvVar,
+				}, false),
+				Description: `Validate feed log types against the catalogue of supported log types during plan. Log types are not validated,
+				 and a warning is logged, when the catalogue cannot be listed. It may be replaced by CHRONICLE_VALIDATE_LOG_TYPES environment variable.`,
 			},
 		},
 
-		DataSourcesMap: map[string]*schema.Resource{},
+		DataSourcesMap: map[string]*schema.Resource{
+			"chronicle_feed":                 dataSourceFeed(),
+			"chronicle_feeds":                dataSourceFeeds(),
+			"chronicle_feed_service_account": dataSourceFeedServiceAccount(),
+			"chronicle_feed_status":          dataSourceFeedStatus(),
+			"chronicle_forwarder_config":     dataSourceForwarderConfig(),
+			"chronicle_log_types":            dataSourceLogTypes(),
+		},
 
+		// chronicle_rule and chronicle_reference_list are served by the plugin framework provider.
 		ResourcesMap: map[string]*schema.Resource{
-			"chronicle_rbac_subject":                                  resourceRBACSubject(),
-			"chronicle_rule":                                          resourceRule(),
-			"chronicle_reference_list":                                resourceReferenceList(),
-			"chronicle_feed_amazon_s3":                                NewResourceFeedAmazonS3().TerraformResource,
-			"chronicle_feed_amazon_sqs":                               NewResourceFeedAmazonSQS().TerraformResource,
-			"chronicle_feed_qualys_vm":                                NewResourceFeedQualysVM().TerraformResource,
-			"chronicle_feed_microsoft_office_365_management_activity": NewResourceFeedMicrosoftOffice365ManagementActivity().TerraformResource,
-			"chronicle_feed_okta_system_log":                          NewResourceFeedOktaSystemLog().TerraformResource,
-			"chronicle_feed_okta_users":                               NewResourceFeedOktaUsers().TerraformResource,
-			"chronicle_feed_proofpoint_siem":                          NewResourceFeedProofpointSIEM().TerraformResource,
-			"chronicle_feed_google_cloud_storage_bucket":              NewResourceFeedGoogleCloudStorageBucket().TerraformResource,
-			"chronicle_feed_azure_blobstore":                          NewResourceFeedAzureBlobStore().TerraformResource,
-			"chronicle_feed_thinkst_canary":                           NewResourceFeedThinkstCanary().TerraformResource,
+			"chronicle_rbac_subject":                  resourceRBACSubject(),
+			"chronicle_feed":                          resourceFeed(),
+			"chronicle_forwarder":                     resourceForwarder(),
+			"chronicle_forwarder_collector":           resourceForwarderCollector(),
+			"chronicle_feed_http":                     NewResourceFeedHTTP().TerraformResource,
+			"chronicle_feed_webhook":                  NewResourceFeedWebhook().TerraformResource,
+			"chronicle_feed_google_cloud_pubsub_push": NewResourceFeedGoogleCloudPubSubPush().TerraformResource,
+			"chronicle_feed_amazon_kinesis_firehose":  NewResourceFeedAmazonKinesisFirehose().TerraformResource,
+			"chronicle_feed_azure_event_hub":          NewResourceFeedAzureEventHub().TerraformResource,
+			"chronicle_feed_google_cloud_storage_v2":  NewResourceFeedGoogleCloudStorageV2().TerraformResource,
 		},
 	}
 
+	for name, resource := range registeredFeedResources() {
+		provider.ResourcesMap[name] = resource
+	}
+
+	for attribute, endpointSchema := range customEndpointsSchema() {
+		provider.Schema[attribute] = endpointSchema
+	}
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		return providerConfigure(ctx, d, provider)
 	}
@@ -227,7 +236,12 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		}
 	}
 
-	opts := getAPIAuthOpts(d)
+	opts := []chronicle.Option{chronicle.WithBaseURLTemplate(readStringFromResource(d, "base_url_template"))}
+	opts = append(opts, getInstanceOpts(d)...)
+	opts = append(opts, chronicle.WithCustomerID(readStringFromResource(d, "customer_id")))
+	opts = append(opts, chronicle.WithLogTypeValidation(readBoolFromResource(d, "validate_log_types")))
+	opts = append(opts, getImpersonationOpts(d)...)
+	opts = append(opts, getAPIAuthOpts(d)...)
 
 	if v, ok := d.GetOk("request_timeout"); ok {
 		opts = append(opts, chronicle.WithRequestTimeout(time.Duration(v.(int))*time.Second))
@@ -251,32 +265,36 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		return nil, diag.FromErr(err)
 	}
 
-	if endpoint, isCustom := customEndpoint(d, "events_custom_endpoint"); isCustom {
-		client.WithEventsBasePath(endpoint)
-	}
-	if endpoint, isCustom := customEndpoint(d, "alert_custom_endpoint"); isCustom {
-		client.WithAlertBasePath(endpoint)
-	}
-	if endpoint, isCustom := customEndpoint(d, "artifact_custom_endpoint"); isCustom {
-		client.WithArtifactBasePath(endpoint)
-	}
-	if endpoint, isCustom := customEndpoint(d, "alias_custom_endpoint"); isCustom {
-		client.WithAliasBasePath(endpoint)
-	}
-	if endpoint, isCustom := customEndpoint(d, "asset_custom_endpoint"); isCustom {
-		client.WithAssetBasePath(endpoint)
-	}
-	if endpoint, isCustom := customEndpoint(d, "ioc_custom_endpoint"); isCustom {
-		client.WithIOCBasePath(endpoint)
+	for _, endpoint := range customEndpoints {
+		if uri, isCustom := customEndpoint(d, endpoint.attribute); isCustom {
+			endpoint.setBasePath(client, uri)
+		}
 	}
-	if endpoint, isCustom := customEndpoint(d, "rule_custom_endpoint"); isCustom {
-		client.WithRuleBasePath(endpoint)
+
+	return client, nil
+}
+
+func getInstanceOpts(d *schema.ResourceData) []chronicle.Option {
+	opts := make([]chronicle.Option, 0)
+
+	apiVersion := readStringFromResource(d, "api_version")
+	if apiVersion != "" && apiVersion != chronicle.APIVersionLegacy {
+		opts = append(opts, chronicle.WithInstance(apiVersion, readStringFromResource(d, "project"),
+			readStringFromResource(d, "location"), readStringFromResource(d, "instance_id")))
 	}
-	if endpoint, isCustom := customEndpoint(d, "subjects_custom_endpoint"); isCustom {
-		client.WithSubjectsBasePath(endpoint)
+
+	return opts
+}
+
+func getImpersonationOpts(d *schema.ResourceData) []chronicle.Option {
+	opts := make([]chronicle.Option, 0)
+
+	if v, ok := d.GetOk("impersonate_service_account"); ok {
+		delegates := readStringSliceFromResource(d, "impersonate_service_account_delegates")
+		opts = append(opts, chronicle.WithImpersonateServiceAccount(v.(string), delegates))
 	}
 
-	return client, nil
+	return opts
 }
 
 func getAPIAuthOpts(d *schema.ResourceData) []chronicle.Option {
@@ -286,49 +304,85 @@ func getAPIAuthOpts(d *schema.ResourceData) []chronicle.Option {
 		opts = append(opts, chronicle.WithBigQueryAPICredentials(v.(string)))
 	} else if v, ok := d.GetOk("bigqueryapi_access_token"); ok {
 		opts = append(opts, chronicle.WithBigQueryAPIAccessToken(v.(string)))
+	} else if env := envSearch(chronicle.BigQueryAPIEnvVar); env != "" {
+		opts = append(opts, chronicle.WithBigQueryAPIEnvVar())
 	} else {
-		env := envSearch(chronicle.BigQueryAPIEnvVar)
-		if env != "" {
-			opts = append(opts, chronicle.WithBigQueryAPIEnvVar())
-		}
+		opts = append(opts, chronicle.WithBigQueryAPIDefaultCredentials())
 	}
 
 	if v, ok := d.GetOk("backstoryapi_credentials"); ok {
 		opts = append(opts, chronicle.WithBackstoryAPICredentials(v.(string)))
-	} else if v, ok := d.GetOk("backstoryapi_credentials"); ok {
+	} else if v, ok := d.GetOk("backstoryapi_access_token"); ok {
 		opts = append(opts, chronicle.WithBackstoryAPIAccessToken(v.(string)))
+	} else if env := envSearch(chronicle.BackstoryAPIEnvVar); env != "" {
+		opts = append(opts, chronicle.WithBackstoryAPIEnvVar())
 	} else {
-		env := envSearch(chronicle.BackstoryAPIEnvVar)
-		if env != "" {
-			opts = append(opts, chronicle.WithBackstoryAPIEnvVar())
-		}
+		opts = append(opts, chronicle.WithBackstoryAPIDefaultCredentials())
 	}
 
 	if v, ok := d.GetOk("ingestionapi_credentials"); ok {
 		opts = append(opts, chronicle.WithIngestionAPICredentials(v.(string)))
-	} else if v, ok := d.GetOk("ingestionapi_credentials"); ok {
+	} else if v, ok := d.GetOk("ingestionapi_access_token"); ok {
 		opts = append(opts, chronicle.WithIngestionAPIAccessToken(v.(string)))
+	} else if env := envSearch(chronicle.IngestionAPIEnvVar); env != "" {
+		opts = append(opts, chronicle.WithIngestionAPIEnvVar())
 	} else {
-		env := envSearch(chronicle.IngestionAPIEnvVar)
-		if env != "" {
-			opts = append(opts, chronicle.WithIngestionAPIEnvVar())
-		}
+		opts = append(opts, chronicle.WithIngestionAPIDefaultCredentials())
 	}
 
 	if v, ok := d.GetOk("forwarderapi_credentials"); ok {
 		opts = append(opts, chronicle.WithForwarderAPICredentials(v.(string)))
 	} else if v, ok := d.GetOk("forwarderapi_access_token"); ok {
 		opts = append(opts, chronicle.WithForwarderAPIAccessToken(v.(string)))
+	} else if env := envSearch(chronicle.ForwarderAPIEnvVar); env != "" {
+		opts = append(opts, chronicle.WithForwarderAPIEnvVar())
 	} else {
-		env := envSearch(chronicle.ForwarderAPIEnvVar)
-		if env != "" {
-			opts = append(opts, chronicle.WithBigQueryAPIEnvVar())
-		}
+		opts = append(opts, chronicle.WithForwarderAPIDefaultCredentials())
 	}
 
 	return opts
 }
 
+// customEndpoints lists every client base path that may be overridden through the provider configuration.
+var customEndpoints = []struct {
+	attribute   string
+	name        string
+	setBasePath func(*chronicle.Client, string) *chronicle.Client
+}{
+	{attribute: "events_custom_endpoint", name: "events", setBasePath: (*chronicle.Client).WithEventsBasePath},
+	{attribute: "alert_custom_endpoint", name: "alert", setBasePath: (*chronicle.Client).WithAlertBasePath},
+	{attribute: "artifact_custom_endpoint", name: "artifact", setBasePath: (*chronicle.Client).WithArtifactBasePath},
+	{attribute: "alias_custom_endpoint", name: "alias", setBasePath: (*chronicle.Client).WithAliasBasePath},
+	{attribute: "asset_custom_endpoint", name: "asset", setBasePath: (*chronicle.Client).WithAssetBasePath},
+	{attribute: "ioc_custom_endpoint", name: "ioc", setBasePath: (*chronicle.Client).WithIOCBasePath},
+	{attribute: "rule_custom_endpoint", name: "rule", setBasePath: (*chronicle.Client).WithRuleBasePath},
+	{attribute: "feed_custom_endpoint", name: "feed", setBasePath: (*chronicle.Client).WithFeedManagementBasePath},
+	{attribute: "subjects_custom_endpoint", name: "subjects", setBasePath: (*chronicle.Client).WithSubjectsBasePath},
+	{attribute: "reference_lists_custom_endpoint", name: "reference lists", setBasePath: (*chronicle.Client).WithReferenceListsBasePath},
+	{attribute: "forwarder_custom_endpoint", name: "forwarder", setBasePath: (*chronicle.Client).WithForwarderBasePath},
+	{attribute: "ingestion_custom_endpoint", name: "ingestion", setBasePath: (*chronicle.Client).WithIngestionBasePath},
+	{attribute: "log_types_custom_endpoint", name: "log types", setBasePath: (*chronicle.Client).WithLogTypesBasePath},
+	{attribute: "api_keys_custom_endpoint", name: "API keys", setBasePath: (*chronicle.Client).WithAPIKeysBasePath},
+}
+
+func customEndpointsSchema() map[string]*schema.Schema {
+	endpointsSchema := make(map[string]*schema.Schema, len(customEndpoints))
+
+	for _, endpoint := range customEndpoints {
+		endpointsSchema[endpoint.attribute] = &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      fmt.Sprintf(`Custom URL to %s endpoint.`, endpoint.name),
+			ValidateDiagFunc: validateCustomEndpoint,
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+				fmt.Sprintf("CHRONICLE_%s", strings.ToUpper(endpoint.attribute)),
+			}, nil),
+		}
+	}
+
+	return endpointsSchema
+}
+
 func customEndpoint(d *schema.ResourceData, endpoint string) (string, bool) {
 	custom, ok := d.GetOk(endpoint)
 	if ok {
@@ -1,17 +1,31 @@
 package chronicle
 
 import (
+	"context"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var testAccProviders map[string]*schema.Provider
 var testAccProvider *schema.Provider
 
+// testAccProtoV5ProviderFactories serve the SDKv2 and plugin framework providers muxed as in main, which acceptance
+// tests of resources migrated to the plugin framework must use.
+var testAccProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"chronicle": func() (tfprotov5.ProviderServer, error) {
+		return newTestMuxServer(context.Background())
+	},
+}
+
 func init() {
 	testAccProvider = Provider()
 	testAccProviders = map[string]*schema.Provider{
@@ -19,6 +33,19 @@ func init() {
 	}
 }
 
+func newTestMuxServer(ctx context.Context) (tfprotov5.ProviderServer, error) {
+	sdkProvider := Provider()
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(NewFrameworkProvider(sdkProvider)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer(), nil
+}
+
 func TestProvider(t *testing.T) {
 	t.Parallel()
 	if err := Provider().InternalValidate(); err != nil {
@@ -30,6 +57,137 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+// TestMuxServer_GetProviderSchema checks that the muxed providers have the same provider schema and no resource in
+// common.
+func TestMuxServer_GetProviderSchema(t *testing.T) {
+	ctx := context.Background()
+	server, err := newTestMuxServer(ctx)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	resp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	for _, name := range []string{"chronicle_rule", "chronicle_reference_list", "chronicle_feed"} {
+		if _, ok := resp.ResourceSchemas[name]; !ok {
+			t.Errorf("expected resource %s to be served", name)
+		}
+	}
+}
+
+// testUpgradeSDKState upgrades the state of a resource written by its SDKv2 implementation with the muxed providers,
+// returning the attributes of the upgraded state.
+func testUpgradeSDKState(t *testing.T, typeName, rawState string) map[string]tftypes.Value {
+	t.Helper()
+
+	ctx := context.Background()
+	server, err := newTestMuxServer(ctx)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  0,
+		RawState: &tfprotov5.RawState{JSON: []byte(rawState)},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("%s: %s", d.Summary, d.Detail)
+	}
+
+	state, err := resp.UpgradedState.Unmarshal(schemas.ResourceSchemas[typeName].ValueType())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := state.As(&attributes); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return attributes
+}
+
+func TestProviderConfigure_CustomEndpoints(t *testing.T) {
+	raw := map[string]interface{}{
+		"bigqueryapi_access_token":  "token",
+		"backstoryapi_access_token": "token",
+		"ingestionapi_access_token": "token",
+		"forwarderapi_access_token": "token",
+	}
+	for _, endpoint := range customEndpoints {
+		raw[endpoint.attribute] = "http://localhost:8080/" + endpoint.attribute
+	}
+
+	provider := Provider()
+	meta, diags := providerConfigure(context.Background(), schema.TestResourceDataRaw(t, provider.Schema, raw), provider)
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	client := meta.(*chronicle.Client)
+	basePaths := map[string]string{
+		"events_custom_endpoint":          client.EventsBasePath,
+		"alert_custom_endpoint":           client.AlertBasePath,
+		"artifact_custom_endpoint":        client.ArtifactBasePath,
+		"alias_custom_endpoint":           client.AliasBasePath,
+		"asset_custom_endpoint":           client.AssetBasePath,
+		"ioc_custom_endpoint":             client.IOCBasePath,
+		"rule_custom_endpoint":            client.RuleBasePath,
+		"feed_custom_endpoint":            client.FeedManagementBasePath,
+		"subjects_custom_endpoint":        client.SubjectsBasePath,
+		"reference_lists_custom_endpoint": client.ReferenceListsBasePath,
+		"forwarder_custom_endpoint":       client.ForwarderBasePath,
+		"ingestion_custom_endpoint":       client.IngestionBasePath,
+		"log_types_custom_endpoint":       client.LogTypesBasePath,
+		"api_keys_custom_endpoint":        client.APIKeysBasePath,
+	}
+	for attribute, basePath := range basePaths {
+		if basePath != raw[attribute] {
+			t.Errorf("%s: expected base path %q, got %q", attribute, raw[attribute], basePath)
+		}
+	}
+}
+
+func TestProviderConfigure_DefaultCredentialsError(t *testing.T) {
+	credentialsPath := filepath.Join(t.TempDir(), "missing.json")
+	t.Setenv("GOOGLE_APPLICATION_CREDENTIALS", credentialsPath)
+	for _, envVar := range chronicle.EnvAPICrendetialsVar {
+		t.Setenv(envVar, "")
+	}
+
+	raw := map[string]interface{}{
+		"impersonate_service_account": "chronicle@test.iam.gserviceaccount.com",
+	}
+
+	provider := Provider()
+	meta, diags := providerConfigure(context.Background(), schema.TestResourceDataRaw(t, provider.Schema, raw), provider)
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	_, err := meta.(*chronicle.Client).GetRule("ru_test")
+	if err == nil || !strings.Contains(err.Error(), credentialsPath) {
+		t.Fatalf("expected the error loading %s, got %v", credentialsPath, err)
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := multiEnvSearch(chronicle.EnvAPICrendetialsVar); v == "" {
 		t.Fatalf("One of %s must be set for acceptance tests", strings.Join(chronicle.EnvAPICrendetialsVar, ", "))
@@ -0,0 +1,312 @@
+package chronicle
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceFeed() *schema.Resource {
+	resource := &schema.Resource{
+		Create: resourceFeedGenericCreate,
+		Read:   resourceFeedGenericRead,
+		Update: resourceFeedGenericUpdate,
+		Delete: resourceFeedDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFeedImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(FiveMinutesTimeout),
+			Update: schema.DefaultTimeout(FiveMinutesTimeout),
+			Delete: schema.DefaultTimeout(FiveMinutesTimeout),
+			Read:   schema.DefaultTimeout(FiveMinutesTimeout),
+		},
+
+		CustomizeDiff: validateFeedLogTypeDiff,
+
+		Description: `Creates a feed of any source and log type, its settings are given as raw JSON.
+Prefer the typed feed resources when one exists for the feed type.`,
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Name to be displayed.`,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: `Enabled specifies whether a feed is allowed to be executed.`,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `State gives some insight into the current state of a feed.`,
+			},
+			"feed_source_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Feed Source Type describes how data is collected, e.g. AMAZON_S3, HTTP or API.`,
+			},
+			"log_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Log Type is a label which describes the nature of the data being ingested.`,
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The namespace the feed will be associated with.`,
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: `All of the events that result from this feed will have this label applied.`,
+			},
+			"details_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validateFeedDetailsJSON,
+				DiffSuppressFunc: suppressEquivalentJSONDiffs,
+				Description: `Settings of the feed encoded in JSON, as an object with a single settings key,
+				 e.g. {"httpSettings": {"uri": "https://example.com"}}. Settings are named as in the Feed Management API.`,
+			},
+			"sensitive_paths": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: `Dot separated paths in "details_json" of the settings not returned by the API, such as secrets,
+				 e.g. "httpSettings.authentication.secret". List items are stepped through by index,
+				 e.g. "httpSettings.authentication.headerKeyValues.0.value". Their configured value is kept instead of the read one.`,
+			},
+			"wait_for_first_run": waitForFirstRunSchema(),
+		},
+	}
+
+	for attribute, attributeSchema := range feedStatusSchema() {
+		resource.Schema[attribute] = attributeSchema
+	}
+	for attribute, attributeSchema := range feedSchedulingSchema() {
+		resource.Schema[attribute] = attributeSchema
+	}
+
+	return resource
+}
+
+func resourceFeedGenericCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	conf, err := expandGenericFeedConfiguration(d)
+	if err != nil {
+		return err
+	}
+
+	since := time.Now()
+	id, err := client.CreateFeed(readStringFromResource(d, "display_name"), readStringFromResource(d, "log_type"),
+		readStringFromResource(d, "namespace"), extractLabelsFromFeedResource(d), expandFeedScheduling(d), conf)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	if !readBoolFromResource(d, "enabled") {
+		err = client.ChangeEnableFeed(id, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := waitForFeedRun(d, client, d.Timeout(schema.TimeoutCreate), since); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished creating Feed %q", d.Id())
+
+	return resourceFeedGenericRead(d, meta)
+}
+
+func resourceFeedGenericRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	baseFeed, conf, err := client.ReadGenericFeed(d.Id())
+	if err != nil {
+		return HandleNotFoundError(err, d, d.Id())
+	}
+
+	err = setBaseFeedProperties(d, *baseFeed)
+	if err != nil {
+		return err
+	}
+	if !isPushFeedSourceType(baseFeed.Details.SourceType) {
+		if err := setFeedSchedulingProperties(d, baseFeed.Details.FeedScheduling); err != nil {
+			return err
+		}
+	}
+
+	details, err := flattenGenericFeedDetails(readStringFromResource(d, "details_json"), conf, readStringSliceFromResource(d, "sensitive_paths"))
+	if err != nil {
+		return fmt.Errorf("error flattening Details: %s", err)
+	}
+	if err := d.Set("details_json", details); err != nil {
+		return fmt.Errorf("error setting Details: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFeedGenericUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+	updated := false
+	since := time.Now()
+
+	if d.HasChange("details_json") || d.HasChange("display_name") || d.HasChange("log_type") ||
+		d.HasChange("namespace") || d.HasChange("labels") || d.HasChange("schedule") || d.HasChange("backfill_start_time") {
+		updated = true
+		conf, err := expandGenericFeedConfiguration(d)
+		if err != nil {
+			return err
+		}
+
+		err = client.UpdateFeed(d.Id(), readStringFromResource(d, "display_name"), readStringFromResource(d, "log_type"),
+			readStringFromResource(d, "namespace"), extractLabelsFromFeedResource(d), expandFeedScheduling(d), conf)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("enabled") {
+		updated = true
+		err := client.ChangeEnableFeed(d.Id(), readBoolFromResource(d, "enabled"))
+		if err != nil {
+			return err
+		}
+	}
+
+	if updated {
+		if err := waitForFeedRun(d, client, d.Timeout(schema.TimeoutUpdate), since); err != nil {
+			return err
+		}
+	}
+
+	return resourceFeedGenericRead(d, meta)
+}
+
+func expandGenericFeedConfiguration(d *schema.ResourceData) (*chronicle.GenericFeedConfiguration, error) {
+	propertyKey, settings, err := parseFeedDetailsJSON(readStringFromResource(d, "details_json"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &chronicle.GenericFeedConfiguration{
+		PropertyKey: propertyKey,
+		SourceType:  readStringFromResource(d, "feed_source_type"),
+		Settings:    settings,
+	}, nil
+}
+
+// parseFeedDetailsJSON returns the settings key of details_json along with its settings.
+func parseFeedDetailsJSON(detailsJSON string) (string, map[string]interface{}, error) {
+	var details map[string]interface{}
+	if err := json.Unmarshal([]byte(detailsJSON), &details); err != nil {
+		return "", nil, fmt.Errorf("details_json is not a valid JSON object: %s", err)
+	}
+	if len(details) != 1 {
+		return "", nil, fmt.Errorf("details_json must have exactly one settings key, got %d", len(details))
+	}
+
+	for key, value := range details {
+		settings, ok := value.(map[string]interface{})
+		if !ok {
+			return "", nil, fmt.Errorf("details_json settings key %q must hold an object", key)
+		}
+		return key, settings, nil
+	}
+
+	return "", nil, nil
+}
+
+// flattenGenericFeedDetails returns the read settings restricted to the keys of the original details_json,
+// so that the defaults filled in by the API do not show up as changes. Sensitive paths keep their original value.
+// On import there is no original value, so every read setting is returned.
+func flattenGenericFeedDetails(originalJSON string, readConf *chronicle.GenericFeedConfiguration, sensitivePaths []string) (string, error) {
+	read := map[string]interface{}{readConf.PropertyKey: readConf.Settings}
+
+	if originalJSON == "" {
+		result, err := json.Marshal(read)
+		return string(result), err
+	}
+
+	var original map[string]interface{}
+	if err := json.Unmarshal([]byte(originalJSON), &original); err != nil {
+		return "", err
+	}
+
+	result := restrictToKeysOf(read, original).(map[string]interface{})
+	for _, path := range sensitivePaths {
+		if value := getFeedSetting(original, path); value != nil {
+			setFeedSetting(result, path, value)
+		}
+	}
+
+	resultJSON, err := json.Marshal(result)
+	return string(resultJSON), err
+}
+
+// restrictToKeysOf drops the keys of read objects missing from the matching original objects, stepping through the
+// objects and lists they both hold. List items without an original counterpart are kept whole.
+func restrictToKeysOf(read, original interface{}) interface{} {
+	switch originalValue := original.(type) {
+	case map[string]interface{}:
+		readMap, ok := read.(map[string]interface{})
+		if !ok {
+			return read
+		}
+
+		result := make(map[string]interface{}, len(originalValue))
+		for k, originalItem := range originalValue {
+			if readItem, ok := readMap[k]; ok {
+				result[k] = restrictToKeysOf(readItem, originalItem)
+			}
+		}
+		return result
+	case []interface{}:
+		readList, ok := read.([]interface{})
+		if !ok {
+			return read
+		}
+
+		result := make([]interface{}, len(readList))
+		for i, readItem := range readList {
+			if i < len(originalValue) {
+				result[i] = restrictToKeysOf(readItem, originalValue[i])
+			} else {
+				result[i] = readItem
+			}
+		}
+		return result
+	default:
+		return read
+	}
+}
+
+func suppressEquivalentJSONDiffs(k, old, new string, d *schema.ResourceData) bool {
+	var oldValue, newValue interface{}
+	if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newValue); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldValue, newValue)
+}
@@ -0,0 +1,185 @@
+package chronicle
+
+import (
+	"context"
+	"fmt"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type ResourceFeedAmazonKinesisFirehose struct {
+	TerraformResource *schema.Resource
+}
+
+func NewResourceFeedAmazonKinesisFirehose() *ResourceFeedAmazonKinesisFirehose {
+	details := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"split_delimiter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The delimiter used to split records delivered by Firehose into separate log lines, e.g. "\n".`,
+			},
+		},
+	}
+	description := "Creates a feed receiving data from an Amazon Kinesis Data Firehose delivery stream, along with the Google Cloud " +
+		"API key the stream authenticates with, which requires permission to manage API keys of the instance project."
+	feed := &ResourceFeedAmazonKinesisFirehose{}
+	feed.TerraformResource = withFirehoseAPIKey(newPushFeedResourceSchema(details, feed, description, true))
+
+	return feed
+}
+
+// withFirehoseAPIKey adds the API key Firehose authenticates with, on top of the feed secret. The key is sent as a query
+// parameter of the endpoint, as Firehose cannot set any header but the secret one.
+func withFirehoseAPIKey(resource *schema.Resource) *schema.Resource {
+	resource.Schema["api_key_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: `The resource name of the Google Cloud API key created for the feed.`,
+	}
+	resource.Schema["api_key"] = &schema.Schema{
+		Type:      schema.TypeString,
+		Computed:  true,
+		Sensitive: true,
+		Description: "A Google Cloud API key of the instance project, restricted to the Chronicle API, created for the feed. " +
+			"It is rotated along with the secret whenever secret_rotation_trigger changes, and deleted with the feed. " +
+			"It is empty for imported feeds until it is rotated.",
+	}
+	resource.Schema["endpoint_url_with_api_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: `The endpoint_url including api_key, to configure as the HTTP endpoint URL of the delivery stream.`,
+	}
+
+	create, read, update, del, customizeDiff := resource.Create, resource.Read, resource.Update, resource.Delete, resource.CustomizeDiff
+	resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+		if d.Id() == "" || !d.HasChange("secret_rotation_trigger") {
+			return nil
+		}
+
+		for _, key := range []string{"api_key", "api_key_name", "endpoint_url_with_api_key"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return fmt.Errorf("error setting %s as computed: %s", key, err)
+			}
+		}
+		return nil
+	}
+	resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+		if err := create(d, meta); err != nil {
+			return err
+		}
+		if err := rotateFirehoseAPIKey(d, meta); err != nil {
+			return err
+		}
+
+		return setFirehoseEndpointURLWithAPIKey(d)
+	}
+	resource.Read = func(d *schema.ResourceData, meta interface{}) error {
+		if err := read(d, meta); err != nil || d.Id() == "" {
+			return err
+		}
+
+		return setFirehoseEndpointURLWithAPIKey(d)
+	}
+	resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+		if err := update(d, meta); err != nil {
+			return err
+		}
+		if d.HasChange("secret_rotation_trigger") {
+			if err := rotateFirehoseAPIKey(d, meta); err != nil {
+				return err
+			}
+		}
+
+		return setFirehoseEndpointURLWithAPIKey(d)
+	}
+	resource.Delete = func(d *schema.ResourceData, meta interface{}) error {
+		apiKeyName := d.Get("api_key_name").(string)
+		if err := del(d, meta); err != nil {
+			return err
+		}
+		if apiKeyName == "" {
+			return nil
+		}
+
+		if err := meta.(*chronicle.Client).DeleteAPIKey(apiKeyName); err != nil && !IsChronicleAPIErrorWithCode(err, 404) {
+			return err
+		}
+
+		return nil
+	}
+
+	return resource
+}
+
+// rotateFirehoseAPIKey creates a new API key for the feed, then deletes the previous one, if any.
+func rotateFirehoseAPIKey(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	previousName := d.Get("api_key_name").(string)
+	name, key, err := client.CreateChronicleAPIKey(fmt.Sprintf("Chronicle feed %s", d.Id()))
+	if err != nil {
+		return err
+	}
+	if err := d.Set("api_key_name", name); err != nil {
+		return fmt.Errorf("error setting api_key_name: %s", err)
+	}
+	if err := d.Set("api_key", key); err != nil {
+		return fmt.Errorf("error setting api_key: %s", err)
+	}
+
+	if previousName != "" {
+		if err := client.DeleteAPIKey(previousName); err != nil && !IsChronicleAPIErrorWithCode(err, 404) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setFirehoseEndpointURLWithAPIKey(d *schema.ResourceData) error {
+	endpointURL := d.Get("endpoint_url").(string)
+	if apiKey := d.Get("api_key").(string); apiKey != "" {
+		endpointURL = fmt.Sprintf("%s?key=%s", endpointURL, apiKey)
+	}
+	if err := d.Set("endpoint_url_with_api_key", endpointURL); err != nil {
+		return fmt.Errorf("error setting endpoint_url_with_api_key: %s", err)
+	}
+
+	return nil
+}
+
+func (f *ResourceFeedAmazonKinesisFirehose) getLogType() string {
+	return ""
+}
+
+func (f *ResourceFeedAmazonKinesisFirehose) expandConcreteFeedConfiguration(d *schema.ResourceData) chronicle.ConcreteFeedConfiguration {
+	conf := &chronicle.AmazonKinesisFirehoseFeedConfiguration{}
+
+	// details may be an empty block, as every setting is optional
+	resourceDetailsInterface := readSliceFromResource(d, "details")
+	if len(resourceDetailsInterface) == 0 || resourceDetailsInterface[0] == nil {
+		return conf
+	}
+
+	resourceDetails := resourceDetailsInterface[0].(map[string]interface{})
+	conf.SplitDelimiter = resourceDetails["split_delimiter"].(string)
+
+	return conf
+}
+
+//nolint:all
+func (f *ResourceFeedAmazonKinesisFirehose) flattenDetailsFromReadOperation(originalConf chronicle.ConcreteFeedConfiguration, readConf chronicle.ConcreteFeedConfiguration) []map[string]interface{} {
+	readFeedConf := readConf.(*chronicle.AmazonKinesisFirehoseFeedConfiguration)
+
+	return []map[string]interface{}{{
+		"split_delimiter": readFeedConf.SplitDelimiter,
+	}}
+}
@@ -0,0 +1,295 @@
+package chronicle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccChronicleFeedAmazonKinesisFirehose_Basic(t *testing.T) {
+	displayName := "test" + randString(10)
+	logType := "AWS_CLOUDWATCH"
+	enabled := "true"
+	namespace := "test"
+	labels := `"test"="test"`
+	splitDelimiter := `\n`
+
+	rootRef := feedAmazonKinesisFirehoseRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedAmazonKinesisFirehoseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedAmazonKinesisFirehose(displayName, logType, enabled, namespace, labels, splitDelimiter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedAmazonKinesisFirehoseExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "log_type", logType),
+					resource.TestCheckResourceAttr(rootRef, "enabled", enabled),
+					resource.TestCheckResourceAttr(rootRef, "namespace", namespace),
+					resource.TestCheckResourceAttr(rootRef, "details.0.split_delimiter", "\n"),
+					resource.TestCheckResourceAttrSet(rootRef, "secret"),
+					resource.TestCheckResourceAttrSet(rootRef, "api_key"),
+					resource.TestCheckResourceAttrSet(rootRef, "endpoint_url_with_api_key"),
+				),
+			},
+			{
+				ResourceName:      rootRef,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{"display_name", "state", "generate_secret", "secret",
+					"api_key", "api_key_name", "endpoint_url_with_api_key"},
+			},
+		},
+	})
+}
+
+func TestAccChronicleFeedAmazonKinesisFirehose_RotateSecret(t *testing.T) {
+	displayName := "test" + randString(10)
+	logType := "AWS_CLOUDWATCH"
+	enabled := "true"
+	namespace := "test"
+	labels := `"test"="test"`
+	splitDelimiter := `\n`
+
+	var secret string
+	rootRef := feedAmazonKinesisFirehoseRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedAmazonKinesisFirehoseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedAmazonKinesisFirehose(displayName, logType, enabled, namespace, labels, splitDelimiter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedAmazonKinesisFirehoseExists(rootRef),
+					resource.TestCheckResourceAttrWith(rootRef, "secret", func(value string) error {
+						secret = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccCheckChronicleFeedAmazonKinesisFirehoseRotated(displayName, logType, enabled, namespace, labels, splitDelimiter, "2026-01-01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedAmazonKinesisFirehoseExists(rootRef),
+					resource.TestCheckResourceAttrWith(rootRef, "secret", func(value string) error {
+						if value == "" || value == secret {
+							return fmt.Errorf("secret was not rotated")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChronicleFeedAmazonKinesisFirehose_UpdateEnabled(t *testing.T) {
+	displayName := "test" + randString(10)
+	logType := "AWS_CLOUDWATCH"
+	enabled := "true"
+	notEnabled := "false"
+	namespace := "test"
+	labels := `"test"="test"`
+	splitDelimiter := `\n`
+
+	rootRef := feedAmazonKinesisFirehoseRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedAmazonKinesisFirehoseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedAmazonKinesisFirehose(displayName, logType, enabled, namespace, labels, splitDelimiter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedAmazonKinesisFirehoseExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "enabled", enabled),
+				),
+			},
+			{
+				Config: testAccCheckChronicleFeedAmazonKinesisFirehose(displayName, logType, notEnabled, namespace, labels, splitDelimiter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedAmazonKinesisFirehoseExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "enabled", notEnabled),
+					resource.TestCheckResourceAttrSet(rootRef, "secret"),
+				),
+			},
+		},
+	})
+}
+
+func TestFeedAmazonKinesisFirehose_RotateAPIKey(t *testing.T) {
+	var mu sync.Mutex
+	var created, deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/projects/project/locations/global/keys"):
+			name := fmt.Sprintf("projects/123/locations/global/keys/key%d", len(created))
+			created = append(created, name)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"name":     "operations/akmf.0",
+				"done":     true,
+				"response": map[string]interface{}{"name": name},
+			})
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/keyString"):
+			name := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v2/"), "/keyString")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"keyString": "secret-" + name[strings.LastIndex(name, "/")+1:]})
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/v2/"))
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"name": "operations/akmf.1"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := chronicle.NewClient(chronicle.RegionEurope, "test", context.Background(),
+		chronicle.WithBackstoryAPIAccessToken("token"),
+		chronicle.WithInstance(chronicle.APIVersionV1Alpha, "project", "eu", "00000000-0000-0000-0000-000000000000"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	client.WithAPIKeysBasePath(server.URL + "/v2")
+
+	d := schema.TestResourceDataRaw(t, NewResourceFeedAmazonKinesisFirehose().TerraformResource.Schema, map[string]interface{}{})
+	d.SetId("feed")
+	if err := d.Set("endpoint_url", "https://example.com/feeds/feed:importPushLogs"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := rotateFirehoseAPIKey(d, client); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+	if err := setFirehoseEndpointURLWithAPIKey(d); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if got := d.Get("api_key_name").(string); got != "projects/123/locations/global/keys/key1" {
+		t.Errorf("unexpected api_key_name %q", got)
+	}
+	if got := d.Get("endpoint_url_with_api_key").(string); got != "https://example.com/feeds/feed:importPushLogs?key=secret-key1" {
+		t.Errorf("unexpected endpoint_url_with_api_key %q", got)
+	}
+	if !reflect.DeepEqual(deleted, []string{"projects/123/locations/global/keys/key0"}) {
+		t.Errorf("expected the previous key only to be deleted, got %v", deleted)
+	}
+}
+
+func TestFeedAmazonKinesisFirehose_RotationTriggerDiff(t *testing.T) {
+	r := NewResourceFeedAmazonKinesisFirehose().TerraformResource
+	state := &terraform.InstanceState{
+		ID: "feed",
+		Attributes: map[string]string{
+			"id":                        "feed",
+			"display_name":              "test",
+			"log_type":                  "AWS_CLOUDWATCH",
+			"secret_rotation_trigger":   "1",
+			"api_key":                   "key",
+			"api_key_name":              "projects/123/locations/global/keys/key0",
+			"endpoint_url_with_api_key": "https://example.com/feeds/feed:importPushLogs?key=key",
+			"details.#":                 "1",
+			"details.0.split_delimiter": `\n`,
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"display_name":            "test",
+		"log_type":                "AWS_CLOUDWATCH",
+		"secret_rotation_trigger": "2",
+		"details":                 []interface{}{map[string]interface{}{"split_delimiter": `\n`}},
+	})
+
+	diff, err := r.SimpleDiff(context.Background(), state, config, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, key := range []string{"api_key", "api_key_name", "endpoint_url_with_api_key"} {
+		if attr, ok := diff.Attributes[key]; !ok || !attr.NewComputed {
+			t.Errorf("expected %s to be known after apply when secret_rotation_trigger changes", key)
+		}
+	}
+}
+
+//nolint:unparam
+func testAccCheckChronicleFeedAmazonKinesisFirehose(displayName, logType, enabled, namespace, labels, splitDelimiter string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_feed_amazon_kinesis_firehose" "test" {
+			display_name = "%s"
+			log_type = "%s"
+			enabled = %s
+			namespace = "%s"
+			labels = {
+				%s
+			}
+			details {
+				split_delimiter = "%s"
+			}
+			}`, displayName, logType, enabled, namespace, labels, splitDelimiter)
+}
+
+func testAccCheckChronicleFeedAmazonKinesisFirehoseRotated(displayName, logType, enabled, namespace, labels, splitDelimiter, rotationTrigger string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_feed_amazon_kinesis_firehose" "test" {
+			display_name = "%s"
+			log_type = "%s"
+			enabled = %s
+			namespace = "%s"
+			labels = {
+				%s
+			}
+			secret_rotation_trigger = "%s"
+			details {
+				split_delimiter = "%s"
+			}
+			}`, displayName, logType, enabled, namespace, labels, rotationTrigger, splitDelimiter)
+}
+
+func testAccCheckChronicleFeedAmazonKinesisFirehoseExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return NewNotFoundErrorf("ID for %s in state", n)
+		}
+		return nil
+	}
+}
+
+func testAccCheckChronicleFeedAmazonKinesisFirehoseDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "chronicle_feed_amazon_kinesis_firehose.test" {
+			continue
+		}
+
+		if rs.Primary.ID != "" {
+			return fmt.Errorf("Object %q still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+	return nil
+}
+
+//nolint:unparam
+func feedAmazonKinesisFirehoseRef(name string) string {
+	return fmt.Sprintf("chronicle_feed_amazon_kinesis_firehose.%v", name)
+}
@@ -2,6 +2,7 @@ package chronicle
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -40,7 +41,7 @@ func TestAccChronicleFeedAmazonS3_Basic(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.source_delete_options", "details.0.authentication.0.access_key_id",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.source_delete_options", "details.0.authentication.0.access_key_id",
 					"details.0.authentication.0.secret_access_key", "details.0.authentication.0.region"},
 			},
 		},
@@ -93,7 +94,7 @@ func TestAccChronicleFeedAmazonS3_UpdateAuth(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.source_delete_options", "details.0.authentication.0.access_key_id",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.source_delete_options", "details.0.authentication.0.access_key_id",
 					"details.0.authentication.0.secret_access_key", "details.0.authentication.0.region"},
 			},
 		},
@@ -143,7 +144,7 @@ func TestAccChronicleFeedAmazonS3_UpdateEnabled(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.source_delete_options", "details.0.authentication.0.access_key_id",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.source_delete_options", "details.0.authentication.0.access_key_id",
 					"details.0.authentication.0.secret_access_key", "details.0.authentication.0.region"},
 			},
 		},
@@ -193,7 +194,7 @@ func TestAccChronicleFeedAmazonS3_UpdateLogType(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.source_delete_options", "details.0.authentication.0.access_key_id",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.source_delete_options", "details.0.authentication.0.access_key_id",
 					"details.0.authentication.0.secret_access_key", "details.0.authentication.0.region"},
 			},
 		},
@@ -201,6 +202,185 @@ func TestAccChronicleFeedAmazonS3_UpdateLogType(t *testing.T) {
 }
 
 //nolint:unparam
+func TestAccChronicleFeedAmazonS3_UnsupportedLogType(t *testing.T) {
+	displayName := "test" + randString(10)
+	logType := "NOT_A_LOG_TYPE"
+	enabled := "true"
+	namespace := "test"
+	labels := `"test"="test"`
+	s3Uri := "test"
+	s3SourceType := "FILES"
+	sourceDeleteOptions := "SOURCE_DELETION_NEVER"
+	region := "EU_WEST_1"
+	accesKeyID := "XXXXXXXXXXXXXXXXXXXX"
+	secretAccessKey := "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckChronicleFeedAmazonS3(displayName, logType, enabled, namespace, labels, s3Uri, s3SourceType, sourceDeleteOptions, region, accesKeyID, secretAccessKey),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`log type NOT_A_LOG_TYPE is not supported`),
+			},
+		},
+	})
+}
+
+func TestAccChronicleFeedAmazonS3_RoleAuthentication(t *testing.T) {
+	displayName := "test" + randString(10)
+	logType := "GITHUB"
+	enabled := "true"
+	namespace := "test"
+	labels := `"test"="test"`
+	s3Uri := "test"
+	s3SourceType := "FILES"
+	sourceDeleteOptions := "SOURCE_DELETION_NEVER"
+	region := "EU_WEST_1"
+	roleARN := "arn:aws:iam::111111111111:role/chronicle"
+
+	rootRef := feedAmazonS3Ref("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedAmazonS3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedAmazonS3WithRole(displayName, logType, enabled, namespace, labels, s3Uri, s3SourceType, sourceDeleteOptions, region, roleARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedAmazonS3Exists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "details.0.role_authentication.0.region", region),
+					resource.TestCheckResourceAttr(rootRef, "details.0.role_authentication.0.role_arn", roleARN),
+					resource.TestCheckResourceAttrSet(rootRef, "details.0.role_authentication.0.subject_id"),
+					resource.TestCheckResourceAttr(rootRef, "details.0.authentication.#", "0"),
+				),
+			},
+			{
+				ResourceName:            rootRef,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.source_delete_options"},
+			},
+		},
+	})
+}
+
+func TestAccChronicleFeedAmazonS3_ConflictingAuthentication(t *testing.T) {
+	config := `resource "chronicle_feed_amazon_s3" "test" {
+			display_name = "test"
+			log_type = "GITHUB"
+			enabled = true
+			details {
+				s3_uri = "s3://test/"
+				s3_source_type = "FILES"
+				source_delete_options = "SOURCE_DELETION_NEVER"
+				authentication {
+					region = "EU_WEST_1"
+					access_key_id = "XXXXXXXXXXXXXXXXXXXX"
+					secret_access_key = "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+				}
+				role_authentication {
+					region = "EU_WEST_1"
+					role_arn = "arn:aws:iam::111111111111:role/chronicle"
+				}
+			}
+			}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`only one of`),
+			},
+		},
+	})
+}
+
+func TestAccChronicleFeedAmazonS3_WriteOnlySecret(t *testing.T) {
+	displayName := "test" + randString(10)
+	logType := "GITHUB"
+	enabled := "true"
+	namespace := "test"
+	labels := `"test"="test"`
+	s3Uri := "test"
+	s3SourceType := "FILES"
+	sourceDeleteOptions := "SOURCE_DELETION_NEVER"
+	region := "EU_WEST_1"
+	accesKeyID := "XXXXXXXXXXXXXXXXXXXX"
+	secretAccessKey := "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+	secretAccessKey1 := "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX1"
+
+	rootRef := feedAmazonS3Ref("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedAmazonS3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedAmazonS3WriteOnly(displayName, logType, enabled, namespace, labels, s3Uri, s3SourceType, sourceDeleteOptions,
+					region, accesKeyID, secretAccessKey, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedAmazonS3Exists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "details.0.authentication.0.secret_access_key", ""),
+					resource.TestCheckNoResourceAttr(rootRef, "details.0.authentication.0.secret_access_key_wo"),
+					resource.TestCheckResourceAttrSet(rootRef, "secret_hashes.details.0.authentication.0.secret_access_key_wo"),
+					resource.TestCheckResourceAttr(rootRef, "secret_version", "1"),
+				),
+			},
+			{
+				Config: testAccCheckChronicleFeedAmazonS3WriteOnly(displayName, logType, enabled, namespace, labels, s3Uri, s3SourceType, sourceDeleteOptions,
+					region, accesKeyID, secretAccessKey1, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedAmazonS3Exists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "secret_version", "2"),
+				),
+			},
+			{
+				Config: testAccCheckChronicleFeedAmazonS3WriteOnly(displayName, logType, enabled, namespace, labels, s3Uri, s3SourceType, sourceDeleteOptions,
+					region, accesKeyID, secretAccessKey1, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedAmazonS3Exists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "secret_version", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChronicleFeedAmazonS3_UpdateSchedule(t *testing.T) {
+	displayName := "test" + randString(10)
+	backfillStartTime := "2024-01-01T00:00:00Z"
+
+	rootRef := feedAmazonS3Ref("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedAmazonS3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedAmazonS3WithSchedule(displayName, `interval = "1h"`, backfillStartTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedAmazonS3Exists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "schedule.0.interval", "1h"),
+					resource.TestCheckResourceAttr(rootRef, "backfill_start_time", backfillStartTime),
+				),
+			},
+			{
+				Config: testAccCheckChronicleFeedAmazonS3WithSchedule(displayName, `cron = "0 */6 * * *"`, backfillStartTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedAmazonS3Exists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "schedule.0.cron", "0 */6 * * *"),
+					resource.TestCheckResourceAttr(rootRef, "schedule.0.interval", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckChronicleFeedAmazonS3AuthUpdated(t *testing.T, n, region, accessKeyID, secretAccessKey string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -248,6 +428,81 @@ func testAccCheckChronicleFeedAmazonS3(displayName, logType, enabled, namespace,
 			}`, displayName, logType, enabled, namespace, labels, s3Uri, s3SourceType, sourceDeleteOptions, region, accesKeyID, secretAccessKey)
 }
 
+//nolint:unparam
+func testAccCheckChronicleFeedAmazonS3WithRole(displayName, logType, enabled, namespace, labels, s3Uri, s3SourceType,
+	sourceDeleteOptions, region, roleARN string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_feed_amazon_s3" "test" {
+			display_name = "%s"
+			log_type = "%s"
+			enabled = %s
+			namespace = "%s"
+			labels = {
+				%s
+			}
+			details {
+				s3_uri = "s3://%s/"
+				s3_source_type = "%s"
+				source_delete_options = "%s"
+				role_authentication {
+					region = "%s"
+					role_arn = "%s"
+				}
+			}
+			}`, displayName, logType, enabled, namespace, labels, s3Uri, s3SourceType, sourceDeleteOptions, region, roleARN)
+}
+
+//nolint:unparam
+func testAccCheckChronicleFeedAmazonS3WriteOnly(displayName, logType, enabled, namespace, labels, s3Uri, s3SourceType,
+	sourceDeleteOptions, region, accesKeyID, secretAccessKey, rotation string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_feed_amazon_s3" "test" {
+			display_name = "%s"
+			log_type = "%s"
+			enabled = %s
+			namespace = "%s"
+			labels = {
+				%s
+			}
+			details {
+				s3_uri = "s3://%s/"
+				s3_source_type = "%s"
+				source_delete_options = "%s"
+				authentication {
+					region = "%s"
+					access_key_id = "%s"
+					secret_access_key_wo = "%s"
+				}
+			}
+			rotate_secrets = {
+				rotation = "%s"
+			}
+			}`, displayName, logType, enabled, namespace, labels, s3Uri, s3SourceType, sourceDeleteOptions, region, accesKeyID, secretAccessKey, rotation)
+}
+
+func testAccCheckChronicleFeedAmazonS3WithSchedule(displayName, schedule, backfillStartTime string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_feed_amazon_s3" "test" {
+			display_name = "%s"
+			log_type = "GITHUB"
+			enabled = false
+			details {
+				s3_uri = "s3://test/"
+				s3_source_type = "FILES"
+				source_delete_options = "SOURCE_DELETION_NEVER"
+				authentication {
+					region = "EU_WEST_1"
+					access_key_id = "XXXXXXXXXXXXXXXXXXXX"
+					secret_access_key = "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+				}
+			}
+			schedule {
+				%s
+			}
+			backfill_start_time = "%s"
+			}`, displayName, schedule, backfillStartTime)
+}
+
 func testAccCheckChronicleFeedAmazonS3Exists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -46,7 +46,7 @@ func TestAccChronicleFeedAmazonSQS_Basic(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.source_delete_options", "details.0.authentication.0.sqs_access_key_id",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.source_delete_options", "details.0.authentication.0.sqs_access_key_id",
 					"details.0.authentication.0.sqs_secret_access_key", "details.0.authentication.0.s3_authentication"},
 			},
 		},
@@ -95,7 +95,7 @@ func TestAccChronicleFeedAmazonSQS_BasicWithS3Auth(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.source_delete_options", "details.0.authentication.0.sqs_access_key_id",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.source_delete_options", "details.0.authentication.0.sqs_access_key_id",
 					"details.0.authentication.0.sqs_secret_access_key", "details.0.authentication.0.s3_authentication"},
 			},
 		},
@@ -154,7 +154,7 @@ func TestAccChronicleFeedAmazonSQS_UpdateAuth(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.source_delete_options", "details.0.authentication.0.sqs_access_key_id",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.source_delete_options", "details.0.authentication.0.sqs_access_key_id",
 					"details.0.authentication.0.sqs_secret_access_key", "details.0.authentication.0.s3_authentication"},
 			},
 		},
@@ -208,7 +208,7 @@ func TestAccChronicleFeedAmazonSQS_UpdateEnabled(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.source_delete_options", "details.0.authentication.0.sqs_access_key_id",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.source_delete_options", "details.0.authentication.0.sqs_access_key_id",
 					"details.0.authentication.0.sqs_secret_access_key", "details.0.authentication.0.s3_authentication"},
 			},
 		},
@@ -262,7 +262,7 @@ func TestAccChronicleFeedAmazonSQS_UpdateLogType(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.source_delete_options", "details.0.authentication.0.sqs_access_key_id",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.source_delete_options", "details.0.authentication.0.sqs_access_key_id",
 					"details.0.authentication.0.sqs_secret_access_key", "details.0.authentication.0.s3_authentication"},
 			},
 		},
@@ -318,7 +318,7 @@ func TestAccChronicleFeedAmazonSQS_UpdateAccountNumber(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.source_delete_options", "details.0.authentication.0.sqs_access_key_id",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.source_delete_options", "details.0.authentication.0.sqs_access_key_id",
 					"details.0.authentication.0.sqs_secret_access_key", "details.0.authentication.0.s3_authentication"},
 			},
 		},
@@ -374,7 +374,7 @@ func TestAccChronicleFeedAmazonSQS_UpdateRegion(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.source_delete_options", "details.0.authentication.0.sqs_access_key_id",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.source_delete_options", "details.0.authentication.0.sqs_access_key_id",
 					"details.0.authentication.0.sqs_secret_access_key", "details.0.authentication.0.s3_authentication"},
 			},
 		},
@@ -382,6 +382,44 @@ func TestAccChronicleFeedAmazonSQS_UpdateRegion(t *testing.T) {
 }
 
 //nolint:unparam
+func TestAccChronicleFeedAmazonSQS_RoleAuthentication(t *testing.T) {
+	displayName := "test" + randString(10)
+	logType := "ONEPASSWORD"
+	enabled := "true"
+	namespace := "test"
+	labels := `"test"="test"`
+	queue := "test"
+	region := "US_EAST_1"
+	accountNumber := "111111111111"
+	sourceDeleteOptions := "SOURCE_DELETION_NEVER"
+	roleARN := "arn:aws:iam::111111111111:role/chronicle"
+
+	rootRef := feedAmazonSQSRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedAmazonSQSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedAmazonSQSWithRole(displayName, logType, enabled, namespace, labels, queue, region, accountNumber,
+					sourceDeleteOptions, roleARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedAmazonSQSExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "details.0.role_authentication.0.role_arn", roleARN),
+					resource.TestCheckResourceAttrSet(rootRef, "details.0.role_authentication.0.subject_id"),
+					resource.TestCheckResourceAttr(rootRef, "details.0.authentication.#", "0"),
+				),
+			},
+			{
+				ResourceName:            rootRef,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.source_delete_options"},
+			},
+		},
+	})
+}
+
 func testAccCheckChronicleFeedAmazonSQSAuthUpdated(t *testing.T, n, region, sqsAccessKeyID,
 	sqsSecretAccessKey, s3AccessKeyID, s3SecretAccessKey string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -462,6 +500,30 @@ func testAccCheckChronicleFeedAmazonSQSWithS3Auth(displayName, logType, enabled,
 		accountNumber, sourceDeleteOptions, sqsAccesKeyID, sqsSecretAccessKey, s3AccesKeyID, s3SecretAccessKey)
 }
 
+//nolint:unparam
+func testAccCheckChronicleFeedAmazonSQSWithRole(displayName, logType, enabled, namespace, labels, queue, region,
+	accountNumber, sourceDeleteOptions, roleARN string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_feed_amazon_sqs" "test" {
+			display_name = "%s"
+			log_type = "%s"
+			enabled = %s
+			namespace = "%s"
+			labels = {
+				%s
+			}
+			details {
+				queue = "%s"
+				region = "%s"
+				account_number = "%s"
+				source_delete_options = "%s"
+				role_authentication {
+					role_arn = "%s"
+				}
+			}
+			}`, displayName, logType, enabled, namespace, labels, queue, region, accountNumber, sourceDeleteOptions, roleARN)
+}
+
 func testAccCheckChronicleFeedAmazonSQSExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -38,7 +38,7 @@ func TestAccChronicleFeedAzureBlobStore_Basic(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.shared_key",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.shared_key",
 					"details.0.authentication.0.sas_token"},
 			},
 		},
@@ -87,7 +87,7 @@ func TestAccChronicleFeedAzureBlobStore_UpdateAuth(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.shared_key",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.shared_key",
 					"details.0.authentication.0.sas_token"},
 			},
 		},
@@ -136,7 +136,7 @@ func TestAccChronicleFeedAzureBlobStore_UpdateEnabled(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.shared_key",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.shared_key",
 					"details.0.authentication.0.sas_token"},
 			},
 		},
@@ -183,7 +183,7 @@ func TestAccChronicleFeedAzureBlobStore_UpdateLogType(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.shared_key",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.shared_key",
 					"details.0.authentication.0.sas_token"},
 			},
 		},
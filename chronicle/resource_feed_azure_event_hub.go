@@ -0,0 +1,153 @@
+package chronicle
+
+import (
+	"strings"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const FeedAzureEventHubDefaultConsumerGroup = "$Default"
+
+type ResourceFeedAzureEventHub struct {
+	TerraformResource *schema.Resource
+}
+
+func NewResourceFeedAzureEventHub() *ResourceFeedAzureEventHub {
+	details := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The name of the event hub.`,
+			},
+			"consumer_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     FeedAzureEventHubDefaultConsumerGroup,
+				Description: `The consumer group the feed reads events as. It should not be shared with other consumers.`,
+			},
+			"connection_string": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validateAzureEventHubConnectionString,
+				Description: `A connection string of the event hub or of its namespace. It may either use a shared access key,
+				e.g. Endpoint=sb://<namespace>.servicebus.windows.net/;SharedAccessKeyName=<name>;SharedAccessKey=<key>, or a SAS token,
+				e.g. Endpoint=sb://<namespace>.servicebus.windows.net/;SharedAccessSignature=<token>.`,
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The event hub namespace, as found in the endpoint of the connection string.`,
+			},
+			"checkpoint_storage": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: `Azure Blob Storage container the feed stores its checkpoints in.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_string": {
+							Type:             schema.TypeString,
+							Required:         true,
+							Sensitive:        true,
+							ValidateDiagFunc: validateAzureStorageConnectionString,
+							Description:      `A connection string of the storage account.`,
+						},
+						"container": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The name of the blob container.`,
+						},
+					},
+				},
+			},
+		},
+	}
+	description := "Creates a feed from an Azure Event Hub."
+	eventHub := &ResourceFeedAzureEventHub{}
+	eventHub.TerraformResource = newFeedResourceSchema(details, eventHub, description, true)
+
+	return eventHub
+}
+
+func (f *ResourceFeedAzureEventHub) getLogType() string {
+	return ""
+}
+
+func (f *ResourceFeedAzureEventHub) expandConcreteFeedConfiguration(d *schema.ResourceData) chronicle.ConcreteFeedConfiguration {
+	resourceDetailsInterface := readSliceFromResource(d, "details")
+	if resourceDetailsInterface == nil {
+		return nil
+	}
+
+	resourceDetails := resourceDetailsInterface[0].(map[string]interface{})
+
+	conf := &chronicle.AzureEventHubFeedConfiguration{
+		Name:             resourceDetails["name"].(string),
+		ConsumerGroup:    resourceDetails["consumer_group"].(string),
+		ConnectionString: resourceDetails["connection_string"].(string),
+	}
+
+	if storage := resourceDetails["checkpoint_storage"].([]interface{}); len(storage) > 0 && storage[0] != nil {
+		storageDetails := storage[0].(map[string]interface{})
+		conf.StorageConnectionString = storageDetails["connection_string"].(string)
+		conf.StorageContainer = storageDetails["container"].(string)
+	}
+
+	return conf
+}
+
+// azureEventHubNamespace returns the namespace from the endpoint of an event hub connection string,
+// e.g. mynamespace for Endpoint=sb://mynamespace.servicebus.windows.net/.
+func azureEventHubNamespace(connectionString string) string {
+	settings, err := parseAzureConnectionString(connectionString)
+	if err != nil {
+		return ""
+	}
+	host := strings.TrimPrefix(settings["endpoint"], "sb://")
+
+	return strings.Split(host, ".")[0]
+}
+
+//nolint:all
+func (f *ResourceFeedAzureEventHub) flattenDetailsFromReadOperation(originalConf chronicle.ConcreteFeedConfiguration, readConf chronicle.ConcreteFeedConfiguration) []map[string]interface{} {
+	readEventHubConf := readConf.(*chronicle.AzureEventHubFeedConfiguration)
+
+	// Import Case
+	if originalConf == nil {
+		return []map[string]interface{}{{
+			"name":               readEventHubConf.Name,
+			"consumer_group":     readEventHubConf.ConsumerGroup,
+			"connection_string":  readEventHubConf.ConnectionString,
+			"namespace":          azureEventHubNamespace(readEventHubConf.ConnectionString),
+			"checkpoint_storage": flattenAzureEventHubCheckpointStorage(readEventHubConf),
+		}}
+	}
+
+	originalEventHubConf := originalConf.(*chronicle.AzureEventHubFeedConfiguration)
+	// Default Case
+	return []map[string]interface{}{{
+		"name":           readEventHubConf.Name,
+		"consumer_group": readEventHubConf.ConsumerGroup,
+		// replace connection strings with original values because they are not returned within a read request
+		"connection_string": originalEventHubConf.ConnectionString,
+		"namespace":         azureEventHubNamespace(originalEventHubConf.ConnectionString),
+		"checkpoint_storage": flattenAzureEventHubCheckpointStorage(&chronicle.AzureEventHubFeedConfiguration{
+			StorageConnectionString: originalEventHubConf.StorageConnectionString,
+			StorageContainer:        readEventHubConf.StorageContainer,
+		}),
+	}}
+}
+
+func flattenAzureEventHubCheckpointStorage(conf *chronicle.AzureEventHubFeedConfiguration) []map[string]interface{} {
+	if conf.StorageContainer == "" {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"connection_string": conf.StorageConnectionString,
+		"container":         conf.StorageContainer,
+	}}
+}
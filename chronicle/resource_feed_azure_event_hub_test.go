@@ -0,0 +1,170 @@
+package chronicle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccChronicleFeedAzureEventHub_Basic(t *testing.T) {
+	displayName := "test" + randString(10)
+	logType := "AZURE_AD"
+	enabled := "true"
+	namespace := "test"
+	labels := `"test"="test"`
+	name := "test" + randString(10)
+	consumerGroup := "chronicle"
+	connectionString := "Endpoint=sb://test.servicebus.windows.net/;SharedAccessKeyName=chronicle;SharedAccessKey=XXXXXXXXXXXXXXXXXXXX"
+
+	rootRef := feedAzureEventHubRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedAzureEventHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedAzureEventHub(displayName, logType, enabled, namespace, labels, name, consumerGroup, connectionString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedAzureEventHubExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "log_type", logType),
+					resource.TestCheckResourceAttr(rootRef, "enabled", enabled),
+					resource.TestCheckResourceAttr(rootRef, "details.0.name", name),
+					resource.TestCheckResourceAttr(rootRef, "details.0.consumer_group", consumerGroup),
+					resource.TestCheckResourceAttr(rootRef, "details.0.namespace", "test"),
+				),
+			},
+			{
+				ResourceName:            rootRef,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.connection_string", "details.0.namespace"},
+			},
+		},
+	})
+}
+
+func TestAccChronicleFeedAzureEventHub_UpdateAuth(t *testing.T) {
+	displayName := "test" + randString(10)
+	logType := "AZURE_AD"
+	enabled := "true"
+	namespace := "test"
+	labels := `"test"="test"`
+	name := "test" + randString(10)
+	consumerGroup := "chronicle"
+	connectionString := "Endpoint=sb://test.servicebus.windows.net/;SharedAccessKeyName=chronicle;SharedAccessKey=XXXXXXXXXXXXXXXXXXXX"
+	connectionString1 := "Endpoint=sb://test1.servicebus.windows.net/;SharedAccessSignature=SharedAccessSignature sr=test1&sig=XXXX&se=1&skn=chronicle"
+
+	rootRef := feedAzureEventHubRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedAzureEventHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedAzureEventHub(displayName, logType, enabled, namespace, labels, name, consumerGroup, connectionString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedAzureEventHubExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "details.0.connection_string", connectionString),
+				),
+			},
+			{
+				Config: testAccCheckChronicleFeedAzureEventHub(displayName, logType, enabled, namespace, labels, name, consumerGroup, connectionString1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedAzureEventHubExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "details.0.connection_string", connectionString1),
+					resource.TestCheckResourceAttr(rootRef, "details.0.namespace", "test1"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateAzureEventHubConnectionString(t *testing.T) {
+	cases := map[string]bool{
+		"Endpoint=sb://ns.servicebus.windows.net/;SharedAccessKeyName=name;SharedAccessKey=key":                true,
+		"Endpoint=sb://ns.servicebus.windows.net/;SharedAccessKeyName=name;SharedAccessKey=key;EntityPath=hub": true,
+		"endpoint=sb://ns.servicebus.windows.net/;sharedaccesssignature=SharedAccessSignature sr=ns&sig=x":     true,
+		"Endpoint=sb://ns.servicebus.windows.net/;SharedAccessKeyName=name":                                    false,
+		"Endpoint=https://ns.servicebus.windows.net/;SharedAccessKeyName=name;SharedAccessKey=key":             false,
+		"SharedAccessKeyName=name;SharedAccessKey=key":                                                         false,
+		"not a connection string": false,
+	}
+
+	for connectionString, valid := range cases {
+		diags := validateAzureEventHubConnectionString(connectionString, cty.Path{})
+		if diags.HasError() == valid {
+			t.Errorf("expected %q to be valid: %t, got %v", connectionString, valid, diags)
+		}
+	}
+}
+
+func TestValidateAzureStorageConnectionString(t *testing.T) {
+	cases := map[string]bool{
+		"DefaultEndpointsProtocol=https;AccountName=account;AccountKey=key==;EndpointSuffix=core.windows.net": true,
+		"BlobEndpoint=https://account.blob.core.windows.net/;SharedAccessSignature=sv=2022&sig=x":             true,
+		"DefaultEndpointsProtocol=https;AccountName=account":                                                  false,
+	}
+
+	for connectionString, valid := range cases {
+		diags := validateAzureStorageConnectionString(connectionString, cty.Path{})
+		if diags.HasError() == valid {
+			t.Errorf("expected %q to be valid: %t, got %v", connectionString, valid, diags)
+		}
+	}
+}
+
+//nolint:unparam
+func testAccCheckChronicleFeedAzureEventHub(displayName, logType, enabled, namespace, labels, name, consumerGroup, connectionString string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_feed_azure_event_hub" "test" {
+			display_name = "%s"
+			log_type = "%s"
+			enabled = %s
+			namespace = "%s"
+			labels = {
+				%s
+			}
+			details {
+				name = "%s"
+				consumer_group = "%s"
+				connection_string = "%s"
+			}
+			}`, displayName, logType, enabled, namespace, labels, name, consumerGroup, connectionString)
+}
+
+func testAccCheckChronicleFeedAzureEventHubExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return NewNotFoundErrorf("ID for %s in state", n)
+		}
+		return nil
+	}
+}
+
+func testAccCheckChronicleFeedAzureEventHubDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "chronicle_feed_azure_event_hub.test" {
+			continue
+		}
+
+		if rs.Primary.ID != "" {
+			return fmt.Errorf("Object %q still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+	return nil
+}
+
+//nolint:unparam
+func feedAzureEventHubRef(name string) string {
+	return fmt.Sprintf("chronicle_feed_azure_event_hub.%v", name)
+}
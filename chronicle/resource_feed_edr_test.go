@@ -0,0 +1,151 @@
+package chronicle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// edrFeedTypeCases are checked by TestFeedTypeCases.
+func edrFeedTypeCases() []feedTypeCase {
+	var cases []feedTypeCase
+	for _, spec := range []feedTypeSpec{feedTypeCrowdStrikeFalconDetections, feedTypeCrowdStrikeFalconAlerts} {
+		cases = append(cases, feedTypeCase{
+			spec: spec,
+			details: map[string]interface{}{
+				"hostname": "api.eu-1.crowdstrike.com",
+				"authentication": []interface{}{map[string]interface{}{
+					"client_id":     "id",
+					"client_secret": "secret",
+				}},
+			},
+			settings: map[string]interface{}{
+				"hostname":       "api.eu-1.crowdstrike.com",
+				"authentication": map[string]interface{}{"clientId": "id", "clientSecret": "secret"},
+			},
+			invalid: map[string]interface{}{"hostname": "api.eu-2.crowdstrike.com"},
+		})
+	}
+	for _, spec := range []feedTypeSpec{feedTypeSentinelOneAlerts, feedTypeSentinelOneActivity} {
+		cases = append(cases, feedTypeCase{
+			spec: spec,
+			details: map[string]interface{}{
+				"hostname":       "test.sentinelone.net",
+				"authentication": []interface{}{map[string]interface{}{"api_token": "token"}},
+			},
+			settings: map[string]interface{}{
+				"hostname":       "test.sentinelone.net",
+				"authentication": map[string]interface{}{"apiToken": "token"},
+			},
+			invalid: map[string]interface{}{"hostname": "https://test.sentinelone.net"},
+		})
+	}
+
+	return append(cases, feedTypeCase{
+		spec: feedTypeMicrosoftDefenderForEndpoint,
+		details: map[string]interface{}{
+			"hostname":  "api-eu.securitycenter.microsoft.com",
+			"tenant_id": "50352504-a347-11ed-a8fc-0242ac120001",
+			"authentication": []interface{}{map[string]interface{}{
+				"client_id":     "50352701-a307-11ed-a8fc-0242ac120001",
+				"client_secret": "secret",
+			}},
+		},
+		settings: map[string]interface{}{
+			"hostname": "api-eu.securitycenter.microsoft.com",
+			"tenantId": "50352504-a347-11ed-a8fc-0242ac120001",
+			"authentication": map[string]interface{}{
+				"clientId":     "50352701-a307-11ed-a8fc-0242ac120001",
+				"clientSecret": "secret",
+			},
+		},
+		invalid: map[string]interface{}{
+			"hostname":                   "api.securitycenter.example.com",
+			"tenant_id":                  "tenant",
+			"authentication.0.client_id": "client",
+		},
+	})
+}
+
+func TestAccChronicleFeedCrowdStrikeFalcon_Basic(t *testing.T) {
+	displayName := "testtf" + randString(10)
+	hostname := "api.eu-1.crowdstrike.com"
+	authentication := fmt.Sprintf(`client_id = "%s"
+				client_secret = "%s"`, randString(32), randString(40))
+
+	resourceType := "chronicle_feed_crowdstrike_falcon_detections"
+	rootRef := fmt.Sprintf("%s.test", resourceType)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedEDRDestroy(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedEDR(resourceType, displayName, fmt.Sprintf("hostname = %q", hostname), authentication),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedEDRExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "details.0.hostname", hostname),
+				),
+			},
+			{
+				ResourceName:      rootRef,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.client_id",
+					"details.0.authentication.0.client_secret"},
+			},
+		},
+	})
+}
+
+//nolint:unparam
+func testAccCheckChronicleFeedEDR(resourceType, displayName, details, authentication string) string {
+	return fmt.Sprintf(
+		`resource "%s" "test" {
+			display_name = "%s"
+			enabled = true
+			namespace = "test"
+			labels = {
+				"test"="test"
+			}
+			details {
+				%s
+				authentication {
+				%s
+				}
+			}
+			}`, resourceType, displayName, details, authentication)
+}
+
+func testAccCheckChronicleFeedEDRExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return NewNotFoundErrorf("ID for %s in state", n)
+		}
+		return nil
+	}
+}
+
+func testAccCheckChronicleFeedEDRDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			if rs.Primary.ID != "" {
+				return fmt.Errorf("Object %q still exists", rs.Primary.ID)
+			}
+			return nil
+		}
+		return nil
+	}
+}
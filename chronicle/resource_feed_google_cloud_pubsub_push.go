@@ -0,0 +1,55 @@
+package chronicle
+
+import (
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type ResourceFeedGoogleCloudPubSubPush struct {
+	TerraformResource *schema.Resource
+}
+
+func NewResourceFeedGoogleCloudPubSubPush() *ResourceFeedGoogleCloudPubSubPush {
+	details := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"split_delimiter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The delimiter used to split Pub/Sub messages into separate log lines, e.g. "\n".`,
+			},
+		},
+	}
+	description := "Creates a feed receiving data from a Google Cloud Pub/Sub push subscription."
+	feed := &ResourceFeedGoogleCloudPubSubPush{}
+	feed.TerraformResource = newPushFeedResourceSchema(details, feed, description, true)
+
+	return feed
+}
+
+func (f *ResourceFeedGoogleCloudPubSubPush) getLogType() string {
+	return ""
+}
+
+func (f *ResourceFeedGoogleCloudPubSubPush) expandConcreteFeedConfiguration(d *schema.ResourceData) chronicle.ConcreteFeedConfiguration {
+	conf := &chronicle.PubSubPushFeedConfiguration{}
+
+	// details may be an empty block, as every setting is optional
+	resourceDetailsInterface := readSliceFromResource(d, "details")
+	if len(resourceDetailsInterface) == 0 || resourceDetailsInterface[0] == nil {
+		return conf
+	}
+
+	resourceDetails := resourceDetailsInterface[0].(map[string]interface{})
+	conf.SplitDelimiter = resourceDetails["split_delimiter"].(string)
+
+	return conf
+}
+
+//nolint:all
+func (f *ResourceFeedGoogleCloudPubSubPush) flattenDetailsFromReadOperation(originalConf chronicle.ConcreteFeedConfiguration, readConf chronicle.ConcreteFeedConfiguration) []map[string]interface{} {
+	readFeedConf := readConf.(*chronicle.PubSubPushFeedConfiguration)
+
+	return []map[string]interface{}{{
+		"split_delimiter": readFeedConf.SplitDelimiter,
+	}}
+}
@@ -0,0 +1,177 @@
+package chronicle
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccChronicleFeedGoogleCloudPubSubPush_Basic(t *testing.T) {
+	displayName := "test" + randString(10)
+	logType := "GITHUB"
+	enabled := "true"
+	namespace := "test"
+	labels := `"test"="test"`
+	splitDelimiter := `\n`
+
+	rootRef := feedGoogleCloudPubSubPushRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedGoogleCloudPubSubPushDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedGoogleCloudPubSubPush(displayName, logType, enabled, namespace, labels, splitDelimiter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedGoogleCloudPubSubPushExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "log_type", logType),
+					resource.TestCheckResourceAttr(rootRef, "enabled", enabled),
+					resource.TestCheckResourceAttr(rootRef, "namespace", namespace),
+					resource.TestCheckResourceAttr(rootRef, "details.0.split_delimiter", "\n"),
+					resource.TestCheckResourceAttrSet(rootRef, "secret"),
+				),
+			},
+			{
+				ResourceName:            rootRef,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"display_name", "state", "generate_secret", "secret"},
+			},
+		},
+	})
+}
+
+func TestAccChronicleFeedGoogleCloudPubSubPush_UpdateEnabled(t *testing.T) {
+	displayName := "test" + randString(10)
+	logType := "GITHUB"
+	enabled := "true"
+	notEnabled := "false"
+	namespace := "test"
+	labels := `"test"="test"`
+	splitDelimiter := `\n`
+
+	rootRef := feedGoogleCloudPubSubPushRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedGoogleCloudPubSubPushDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedGoogleCloudPubSubPush(displayName, logType, enabled, namespace, labels, splitDelimiter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedGoogleCloudPubSubPushExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "enabled", enabled),
+				),
+			},
+			{
+				Config: testAccCheckChronicleFeedGoogleCloudPubSubPush(displayName, logType, notEnabled, namespace, labels, splitDelimiter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedGoogleCloudPubSubPushExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "enabled", notEnabled),
+					resource.TestCheckResourceAttrSet(rootRef, "secret"),
+				),
+			},
+		},
+	})
+}
+
+func TestFeedGoogleCloudPubSubPush_SecretDiff(t *testing.T) {
+	cases := []struct {
+		name              string
+		oldGenerateSecret string
+		generateSecret    bool
+		rotationTrigger   string
+		computed          bool
+	}{
+		{name: "unchanged", oldGenerateSecret: "true", generateSecret: true, rotationTrigger: "1"},
+		{name: "rotated", oldGenerateSecret: "true", generateSecret: true, rotationTrigger: "2", computed: true},
+		{name: "rotated without generating", oldGenerateSecret: "false", generateSecret: false, rotationTrigger: "2"},
+		{name: "switched to generating", oldGenerateSecret: "false", generateSecret: true, rotationTrigger: "1", computed: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "feed",
+				Attributes: map[string]string{
+					"id":                      "feed",
+					"display_name":            "test",
+					"log_type":                "GCP_CLOUDAUDIT",
+					"generate_secret":         c.oldGenerateSecret,
+					"secret_rotation_trigger": "1",
+					"secret":                  "secret",
+					"details.#":               "1",
+				},
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"display_name":            "test",
+				"log_type":                "GCP_CLOUDAUDIT",
+				"generate_secret":         c.generateSecret,
+				"secret_rotation_trigger": c.rotationTrigger,
+				"details":                 []interface{}{map[string]interface{}{}},
+			})
+
+			diff, err := NewResourceFeedGoogleCloudPubSubPush().TerraformResource.SimpleDiff(context.Background(), state, config, nil)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			computed := diff != nil && diff.Attributes["secret"] != nil && diff.Attributes["secret"].NewComputed
+			if computed != c.computed {
+				t.Errorf("expected secret to be computed %t, got %t", c.computed, computed)
+			}
+		})
+	}
+}
+
+//nolint:unparam
+func testAccCheckChronicleFeedGoogleCloudPubSubPush(displayName, logType, enabled, namespace, labels, splitDelimiter string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_feed_google_cloud_pubsub_push" "test" {
+			display_name = "%s"
+			log_type = "%s"
+			enabled = %s
+			namespace = "%s"
+			labels = {
+				%s
+			}
+			details {
+				split_delimiter = "%s"
+			}
+			}`, displayName, logType, enabled, namespace, labels, splitDelimiter)
+}
+
+func testAccCheckChronicleFeedGoogleCloudPubSubPushExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return NewNotFoundErrorf("ID for %s in state", n)
+		}
+		return nil
+	}
+}
+
+func testAccCheckChronicleFeedGoogleCloudPubSubPushDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "chronicle_feed_google_cloud_pubsub_push.test" {
+			continue
+		}
+
+		if rs.Primary.ID != "" {
+			return fmt.Errorf("Object %q still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+	return nil
+}
+
+//nolint:unparam
+func feedGoogleCloudPubSubPushRef(name string) string {
+	return fmt.Sprintf("chronicle_feed_google_cloud_pubsub_push.%v", name)
+}
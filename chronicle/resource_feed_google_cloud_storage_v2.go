@@ -0,0 +1,94 @@
+package chronicle
+
+import (
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const FeedGoogleCloudStorageV2DefaultMaxFileAgeDays = 180
+
+type ResourceFeedGoogleCloudStorageV2 struct {
+	TerraformResource *schema.Resource
+}
+
+func NewResourceFeedGoogleCloudStorageV2() *ResourceFeedGoogleCloudStorageV2 {
+	details := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bucket_uri": {
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validateGCSURI,
+				Required:         true,
+				Description:      `The bucket URI to ingest, optionally followed by a folder, e.g. gs://bucket/folder/.`,
+			},
+			"source_delete_options": {
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validateFeedGCSSourceDeleteOption,
+				Optional:         true,
+				Default:          FeedGoogleCloudStorageBucketSourceDeleteOptionDeletionNever,
+				Description: `Whether to delete source files after they have been transferred to Chronicle. The possible values are as follows:
+
+				- SOURCE_DELETION_NEVER: Never delete files from the source.
+				- SOURCE_DELETION_ON_SUCCESS: Delete files and empty directories from the source after successful ingestion.
+				- SOURCE_DELETION_ON_SUCCESS_FILES_ONLY: Delete files from the source after successful ingestion.`,
+			},
+			"max_file_age_days": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          FeedGoogleCloudStorageV2DefaultMaxFileAgeDays,
+				ValidateDiagFunc: validateFeedGCSV2MaxFileAgeDays,
+				Description:      `Only files modified within this number of days are ingested.`,
+			},
+			"include_prefixes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Only objects whose name, relative to the bucket, starts with one of these prefixes are ingested.`,
+			},
+			"exclude_prefixes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Objects whose name, relative to the bucket, starts with one of these prefixes are not ingested.`,
+			},
+		},
+	}
+	description := "Creates a feed from a Google Cloud Storage bucket through Storage Transfer, reading it as the Chronicle feed service account."
+	bucket := &ResourceFeedGoogleCloudStorageV2{}
+	bucket.TerraformResource = newFeedResourceSchema(details, bucket, description, true)
+
+	return bucket
+}
+
+func (f *ResourceFeedGoogleCloudStorageV2) getLogType() string {
+	return ""
+}
+
+func (f *ResourceFeedGoogleCloudStorageV2) expandConcreteFeedConfiguration(d *schema.ResourceData) chronicle.ConcreteFeedConfiguration {
+	resourceDetailsInterface := readSliceFromResource(d, "details")
+	if resourceDetailsInterface == nil {
+		return nil
+	}
+
+	resourceDetails := resourceDetailsInterface[0].(map[string]interface{})
+
+	return &chronicle.GCSV2FeedConfiguration{
+		URI:                 resourceDetails["bucket_uri"].(string),
+		SourceDeleteOptions: resourceDetails["source_delete_options"].(string),
+		MaxLookbackDays:     resourceDetails["max_file_age_days"].(int),
+		IncludePrefixes:     readStringSliceFromResource(d, "details.0.include_prefixes"),
+		ExcludePrefixes:     readStringSliceFromResource(d, "details.0.exclude_prefixes"),
+	}
+}
+
+//nolint:all
+func (f *ResourceFeedGoogleCloudStorageV2) flattenDetailsFromReadOperation(originalConf chronicle.ConcreteFeedConfiguration, readConf chronicle.ConcreteFeedConfiguration) []map[string]interface{} {
+	readBucketConf := readConf.(*chronicle.GCSV2FeedConfiguration)
+
+	return []map[string]interface{}{{
+		"bucket_uri":            readBucketConf.URI,
+		"source_delete_options": readBucketConf.SourceDeleteOptions,
+		"max_file_age_days":     readBucketConf.MaxLookbackDays,
+		"include_prefixes":      readBucketConf.IncludePrefixes,
+		"exclude_prefixes":      readBucketConf.ExcludePrefixes,
+	}}
+}
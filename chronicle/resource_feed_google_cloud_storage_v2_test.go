@@ -0,0 +1,136 @@
+package chronicle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccChronicleFeedGoogleCloudStorageV2_Basic(t *testing.T) {
+	displayName := "test" + randString(10)
+	logType := "GITHUB"
+	enabled := "true"
+	namespace := "test"
+	labels := `"test"="test"`
+	bucketURI := "gs://test" + randString(10) + "/"
+	sourceDeleteOptions := "SOURCE_DELETION_NEVER"
+	maxFileAgeDays := "30"
+
+	rootRef := feedGoogleCloudStorageV2Ref("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedGoogleCloudStorageV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedGoogleCloudStorageV2(displayName, logType, enabled, namespace, labels, bucketURI, sourceDeleteOptions, maxFileAgeDays),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedGoogleCloudStorageV2Exists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "log_type", logType),
+					resource.TestCheckResourceAttr(rootRef, "enabled", enabled),
+					resource.TestCheckResourceAttr(rootRef, "details.0.bucket_uri", bucketURI),
+					resource.TestCheckResourceAttr(rootRef, "details.0.max_file_age_days", maxFileAgeDays),
+					resource.TestCheckResourceAttr(rootRef, "details.0.include_prefixes.0", "logs/"),
+				),
+			},
+			{
+				ResourceName:            rootRef,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"display_name", "state"},
+			},
+		},
+	})
+}
+
+func TestAccChronicleFeedGoogleCloudStorageV2_UpdateMaxFileAge(t *testing.T) {
+	displayName := "test" + randString(10)
+	logType := "GITHUB"
+	enabled := "true"
+	namespace := "test"
+	labels := `"test"="test"`
+	bucketURI := "gs://test" + randString(10) + "/"
+	sourceDeleteOptions := "SOURCE_DELETION_NEVER"
+	maxFileAgeDays := "30"
+	maxFileAgeDays1 := "7"
+
+	rootRef := feedGoogleCloudStorageV2Ref("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedGoogleCloudStorageV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedGoogleCloudStorageV2(displayName, logType, enabled, namespace, labels, bucketURI, sourceDeleteOptions, maxFileAgeDays),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedGoogleCloudStorageV2Exists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "details.0.max_file_age_days", maxFileAgeDays),
+				),
+			},
+			{
+				Config: testAccCheckChronicleFeedGoogleCloudStorageV2(displayName, logType, enabled, namespace, labels, bucketURI, sourceDeleteOptions, maxFileAgeDays1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedGoogleCloudStorageV2Exists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "details.0.max_file_age_days", maxFileAgeDays1),
+				),
+			},
+		},
+	})
+}
+
+//nolint:unparam
+func testAccCheckChronicleFeedGoogleCloudStorageV2(displayName, logType, enabled, namespace, labels, bucketURI, sourceDeleteOptions, maxFileAgeDays string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_feed_google_cloud_storage_v2" "test" {
+			display_name = "%s"
+			log_type = "%s"
+			enabled = %s
+			namespace = "%s"
+			labels = {
+				%s
+			}
+			details {
+				bucket_uri = "%s"
+				source_delete_options = "%s"
+				max_file_age_days = %s
+				include_prefixes = ["logs/"]
+				exclude_prefixes = ["logs/archive/"]
+			}
+			}`, displayName, logType, enabled, namespace, labels, bucketURI, sourceDeleteOptions, maxFileAgeDays)
+}
+
+func testAccCheckChronicleFeedGoogleCloudStorageV2Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return NewNotFoundErrorf("ID for %s in state", n)
+		}
+		return nil
+	}
+}
+
+func testAccCheckChronicleFeedGoogleCloudStorageV2Destroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "chronicle_feed_google_cloud_storage_v2.test" {
+			continue
+		}
+
+		if rs.Primary.ID != "" {
+			return fmt.Errorf("Object %q still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+	return nil
+}
+
+//nolint:unparam
+func feedGoogleCloudStorageV2Ref(name string) string {
+	return fmt.Sprintf("chronicle_feed_google_cloud_storage_v2.%v", name)
+}
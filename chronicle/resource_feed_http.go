@@ -0,0 +1,148 @@
+package chronicle
+
+import (
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	FeedHTTPSourceDeleteOptionDeletionNever              = "SOURCE_DELETION_NEVER"
+	FeedHTTPSourceDeleteOptionDeletionOnSuccess          = "SOURCE_DELETION_ON_SUCCESS"
+	FeedHTTPSourceDeleteOptionDeletionOnSuccessFilesOnly = "SOURCE_DELETION_ON_SUCCESS_FILES_ONLY"
+)
+
+const (
+	FeedHTTPSourceTypeFiles = "FILES"
+)
+
+type ResourceFeedHTTP struct {
+	TerraformResource *schema.Resource
+}
+
+func NewResourceFeedHTTP() *ResourceFeedHTTP {
+	details := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"uri": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateHTTPURI,
+				Description:      `The HTTP(S) URI of the file to ingest.`,
+			},
+			"source_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          FeedHTTPSourceTypeFiles,
+				ValidateDiagFunc: validateFeedHTTPSourceType,
+				Description: `The type of file indicated by the uri. It may be the following:
+
+				- FILES: The URI points to a single file which will be ingested with each execution of the feed.`,
+			},
+			"source_delete_options": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateFeedHTTPSourceDeleteOption,
+				Description: `Whether to delete source files after they have been transferred to Chronicle. The possible values are as follows:
+
+				- SOURCE_DELETION_NEVER: Never delete files from the source.
+				- SOURCE_DELETION_ON_SUCCESS: Delete files and empty directories from the source after successful ingestion.
+				- SOURCE_DELETION_ON_SUCCESS_FILES_ONLY: Delete files from the source after successful ingestion.`,
+			},
+			"authentication": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: `Header sent along with the requests to the URI, e.g. an Authorization header.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Header name.`,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: `Header value.`,
+						},
+					},
+				},
+			},
+		},
+	}
+	description := "Creates a feed from a file served over HTTP(S)."
+	http := &ResourceFeedHTTP{}
+	http.TerraformResource = newFeedResourceSchema(details, http, description, true)
+
+	return http
+}
+
+func (f *ResourceFeedHTTP) getLogType() string {
+	return ""
+}
+
+func (f *ResourceFeedHTTP) expandConcreteFeedConfiguration(d *schema.ResourceData) chronicle.ConcreteFeedConfiguration {
+	resourceDetailsInterface := readSliceFromResource(d, "details")
+	if resourceDetailsInterface == nil {
+		return nil
+	}
+
+	resourceDetails := resourceDetailsInterface[0].(map[string]interface{})
+
+	conf := &chronicle.HTTPFeedConfiguration{
+		URI:                 resourceDetails["uri"].(string),
+		SourceType:          resourceDetails["source_type"].(string),
+		SourceDeleteOptions: resourceDetails["source_delete_options"].(string),
+	}
+
+	if authentication := resourceDetails["authentication"].([]interface{}); len(authentication) > 0 && authentication[0] != nil {
+		authenticationDetails := authentication[0].(map[string]interface{})
+		conf.Authentication = &chronicle.HTTPFeedAuthentication{
+			HeaderKeyValues: []chronicle.HTTPAuthenticationHeaderKeyValues{
+				{
+					Key:   authenticationDetails["key"].(string),
+					Value: authenticationDetails["value"].(string),
+				},
+			},
+		}
+	}
+
+	return conf
+}
+
+//nolint:all
+func (f *ResourceFeedHTTP) flattenDetailsFromReadOperation(originalConf chronicle.ConcreteFeedConfiguration, readConf chronicle.ConcreteFeedConfiguration) []map[string]interface{} {
+
+	readHTTPConf := readConf.(*chronicle.HTTPFeedConfiguration)
+
+	// Import Case
+	if originalConf == nil {
+		return []map[string]interface{}{{
+			"uri":                   readHTTPConf.URI,
+			"source_type":           readHTTPConf.SourceType,
+			"source_delete_options": readHTTPConf.SourceDeleteOptions,
+			"authentication":        flattenHTTPFeedAuthentication(readHTTPConf.Authentication),
+		}}
+	}
+
+	originalHTTPConf := originalConf.(*chronicle.HTTPFeedConfiguration)
+	// Default Case
+	return []map[string]interface{}{{
+		"uri":                   readHTTPConf.URI,
+		"source_type":           readHTTPConf.SourceType,
+		"source_delete_options": originalHTTPConf.SourceDeleteOptions, // not returned
+		// replace authentication block with original values because they are not returned within a read request
+		"authentication": flattenHTTPFeedAuthentication(originalHTTPConf.Authentication),
+	}}
+}
+
+func flattenHTTPFeedAuthentication(authentication *chronicle.HTTPFeedAuthentication) []map[string]interface{} {
+	if authentication == nil || len(authentication.HeaderKeyValues) == 0 {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"key":   authentication.HeaderKeyValues[0].Key,
+		"value": authentication.HeaderKeyValues[0].Value,
+	}}
+}
@@ -0,0 +1,146 @@
+package chronicle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccChronicleFeedHTTP_Basic(t *testing.T) {
+	displayName := "test" + randString(10)
+	logType := "GITHUB"
+	enabled := "true"
+	namespace := "test"
+	labels := `"test"="test"`
+	uri := "https://example.com/" + randString(10) + ".json"
+	sourceType := "FILES"
+	sourceDeleteOptions := "SOURCE_DELETION_NEVER"
+	key := "Authorization"
+	value := "Bearer XXXXXXXXXXXXXXXXXXXX"
+
+	rootRef := feedHTTPRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedHTTPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedHTTP(displayName, logType, enabled, namespace, labels, uri, sourceType, sourceDeleteOptions, key, value),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedHTTPExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "log_type", logType),
+					resource.TestCheckResourceAttr(rootRef, "enabled", enabled),
+					resource.TestCheckResourceAttr(rootRef, "namespace", namespace),
+					resource.TestCheckResourceAttr(rootRef, "details.0.uri", uri),
+				),
+			},
+			{
+				ResourceName:      rootRef,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.source_delete_options", "details.0.authentication.#",
+					"details.0.authentication.0.key", "details.0.authentication.0.value"},
+			},
+		},
+	})
+}
+
+func TestAccChronicleFeedHTTP_UpdateAuth(t *testing.T) {
+	displayName := "test" + randString(10)
+	logType := "GITHUB"
+	enabled := "true"
+	namespace := "test"
+	labels := `"test"="test"`
+	uri := "https://example.com/" + randString(10) + ".json"
+	sourceType := "FILES"
+	sourceDeleteOptions := "SOURCE_DELETION_NEVER"
+	key := "Authorization"
+	value := "Bearer XXXXXXXXXXXXXXXXXXXX"
+	key1 := "X-Api-Key"
+	value1 := "XXXXXXXXXXXXXXXXXXX1"
+
+	rootRef := feedHTTPRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedHTTPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedHTTP(displayName, logType, enabled, namespace, labels, uri, sourceType, sourceDeleteOptions, key, value),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedHTTPExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "details.0.authentication.0.key", key),
+					resource.TestCheckResourceAttr(rootRef, "details.0.authentication.0.value", value),
+				),
+			},
+			{
+				Config: testAccCheckChronicleFeedHTTP(displayName, logType, enabled, namespace, labels, uri, sourceType, sourceDeleteOptions, key1, value1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedHTTPExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "details.0.authentication.0.key", key1),
+					resource.TestCheckResourceAttr(rootRef, "details.0.authentication.0.value", value1),
+				),
+			},
+		},
+	})
+}
+
+//nolint:unparam
+func testAccCheckChronicleFeedHTTP(displayName, logType, enabled, namespace, labels, uri, sourceType,
+	sourceDeleteOptions, key, value string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_feed_http" "test" {
+			display_name = "%s"
+			log_type = "%s"
+			enabled = %s
+			namespace = "%s"
+			labels = {
+				%s
+			}
+			details {
+				uri = "%s"
+				source_type = "%s"
+				source_delete_options = "%s"
+				authentication {
+					key = "%s"
+					value = "%s"
+				}
+			}
+			}`, displayName, logType, enabled, namespace, labels, uri, sourceType, sourceDeleteOptions, key, value)
+}
+
+func testAccCheckChronicleFeedHTTPExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return NewNotFoundErrorf("ID for %s in state", n)
+		}
+		return nil
+	}
+}
+
+func testAccCheckChronicleFeedHTTPDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "chronicle_feed_http.test" {
+			continue
+		}
+
+		if rs.Primary.ID != "" {
+			return fmt.Errorf("Object %q still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+	return nil
+}
+
+//nolint:unparam
+func feedHTTPRef(name string) string {
+	return fmt.Sprintf("chronicle_feed_http.%v", name)
+}
@@ -0,0 +1,145 @@
+package chronicle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// microsoftGraphFeedTypeCases are checked by TestFeedTypeCases.
+func microsoftGraphFeedTypeCases() []feedTypeCase {
+	details := map[string]interface{}{
+		"hostname":      "graph.microsoft.us/v1.0",
+		"auth_endpoint": "login.microsoftonline.us",
+		"tenant_id":     "50352502-a347-11ed-a8fc-0242ac120001",
+		"authentication": []interface{}{map[string]interface{}{
+			"client_id":     "50352701-a307-11ed-a8fc-0242ac120001",
+			"client_secret": "secret",
+		}},
+	}
+	settings := map[string]interface{}{
+		"hostname":     "graph.microsoft.us/v1.0",
+		"authEndpoint": "login.microsoftonline.us",
+		"tenantId":     "50352502-a347-11ed-a8fc-0242ac120001",
+		"authentication": map[string]interface{}{
+			"clientId":     "50352701-a307-11ed-a8fc-0242ac120001",
+			"clientSecret": "secret",
+		},
+	}
+	invalid := map[string]interface{}{
+		"hostname":                   "graph.example.com",
+		"auth_endpoint":              "login.example.com",
+		"tenant_id":                  "tenant",
+		"authentication.0.client_id": "client",
+	}
+
+	var cases []feedTypeCase
+	for _, spec := range []feedTypeSpec{feedTypeMicrosoftEntraIDSignIn, feedTypeMicrosoftEntraIDAudit, feedTypeMicrosoftGraphAlert} {
+		cases = append(cases, feedTypeCase{spec: spec, details: details, settings: settings, invalid: invalid})
+	}
+
+	contextDetails := withTestFeedDetail(details, []string{"retrieve_devices"}, true)
+	contextSettings := map[string]interface{}{"retrieveDevices": true}
+	for key, value := range settings {
+		contextSettings[key] = value
+	}
+
+	return append(cases, feedTypeCase{spec: feedTypeMicrosoftEntraIDContext, details: contextDetails, settings: contextSettings, invalid: invalid})
+}
+
+func TestAccChronicleFeedMicrosoftGraph_Basic(t *testing.T) {
+	displayName := "testtf" + randString(10)
+	enabled := "true"
+	namespace := "test"
+	labels := `"test"="test"`
+	hostname := "graph.microsoft.us/v1.0"
+	authEndpoint := "login.microsoftonline.us"
+	tenantID := "50352503-a347-11ed-a8fc-0242ac120001"
+	clientID := "50352701-a307-11ed-a8fc-0242ac120001"
+	clientSecret := "000"
+
+	resourceType := "chronicle_feed_microsoft_entra_id_audit"
+	rootRef := fmt.Sprintf("%s.test", resourceType)
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedMicrosoftGraphDestroy(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedMicrosoftGraph(resourceType, displayName, enabled, namespace, labels, hostname, authEndpoint, tenantID, clientID, clientSecret),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedMicrosoftGraphExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "details.0.hostname", hostname),
+					resource.TestCheckResourceAttr(rootRef, "details.0.auth_endpoint", authEndpoint),
+				),
+			},
+			{
+				ResourceName:      rootRef,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.hostname", "details.0.auth_endpoint",
+					"details.0.authentication.0.client_id", "details.0.authentication.0.client_secret"},
+			},
+		},
+	})
+}
+
+//nolint:unparam
+func testAccCheckChronicleFeedMicrosoftGraph(resourceType, displayName, enabled, namespace, labels, hostname, authEndpoint, tenantID, clientID, clientSecret string) string {
+	var hostnames string
+	if hostname != "" {
+		hostnames = fmt.Sprintf("hostname = %q\nauth_endpoint = %q", hostname, authEndpoint)
+	}
+
+	return fmt.Sprintf(
+		`resource "%s" "test" {
+			display_name = "%s"
+			enabled = %s
+			namespace = "%s"
+			labels = {
+				%s
+			}
+			details {
+				%s
+				tenant_id = "%s"
+				authentication {
+					client_id = "%s"
+					client_secret = "%s"
+				}
+			}
+			}`, resourceType, displayName, enabled, namespace, labels, hostnames, tenantID, clientID, clientSecret)
+}
+
+func testAccCheckChronicleFeedMicrosoftGraphExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return NewNotFoundErrorf("ID for %s in state", n)
+		}
+		return nil
+	}
+}
+
+func testAccCheckChronicleFeedMicrosoftGraphDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			if rs.Primary.ID != "" {
+				return fmt.Errorf("Object %q still exists", rs.Primary.ID)
+			}
+			return nil
+		}
+		return nil
+	}
+}
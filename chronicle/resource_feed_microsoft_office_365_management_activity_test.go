@@ -41,7 +41,7 @@ func TestAccChronicleFeedMicrosoftOffice365ManagementActivity_Basic(t *testing.T
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.hostname", "details.0.authentication.0.client_id",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.hostname", "details.0.authentication.0.client_id",
 					"details.0.authentication.0.client_secret"},
 			},
 		},
@@ -96,7 +96,7 @@ func TestAccChronicleFeedMicrosoftOffice365ManagementActivity_UpdateAuth(t *test
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.hostname", "details.0.authentication.0.client_id",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.hostname", "details.0.authentication.0.client_id",
 					"details.0.authentication.0.client_secret"},
 			},
 		},
@@ -149,7 +149,7 @@ func TestAccChronicleFeedMicrosoftOffice365ManagementActivity_UpdateEnabled(t *t
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.hostname", "details.0.authentication.0.client_id",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.hostname", "details.0.authentication.0.client_id",
 					"details.0.authentication.0.client_secret"},
 			},
 		},
@@ -0,0 +1,195 @@
+package chronicle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// networkFeedTypeCases are checked by TestFeedTypeCases.
+func networkFeedTypeCases() []feedTypeCase {
+	return []feedTypeCase{
+		{
+			spec: feedTypeCloudflareAudit,
+			details: map[string]interface{}{
+				"account_id":     "0123456789abcdef0123456789abcdef",
+				"authentication": []interface{}{map[string]interface{}{"token": "token"}},
+			},
+			settings: map[string]interface{}{
+				"accountId":      "0123456789abcdef0123456789abcdef",
+				"authentication": map[string]interface{}{"token": "token"},
+			},
+			invalid: map[string]interface{}{"account_id": "account"},
+		},
+		{
+			spec: feedTypeZscaler,
+			details: map[string]interface{}{
+				"cloud_name": "zscalertwo",
+				"authentication": []interface{}{map[string]interface{}{
+					"api_key": "key",
+					"user":    "chronicle@example.com",
+					"secret":  "secret",
+				}},
+			},
+			settings: map[string]interface{}{
+				"cloudName": "zscalertwo",
+				"authentication": map[string]interface{}{
+					"apiKey": "key",
+					"user":   "chronicle@example.com",
+					"secret": "secret",
+				},
+			},
+			invalid: map[string]interface{}{"cloud_name": "zscalerfour"},
+		},
+		{
+			spec: feedTypeNetskope,
+			details: map[string]interface{}{
+				"hostname":           "test.goskope.com",
+				"content_type":       FeedNetskopeContentTypeAlerts,
+				"content_categories": []interface{}{"dlp", "malware"},
+				"authentication":     []interface{}{map[string]interface{}{"token": "token"}},
+			},
+			settings: map[string]interface{}{
+				"hostname":          "test.goskope.com",
+				"contentType":       FeedNetskopeContentTypeAlerts,
+				"contentCategories": []interface{}{"dlp", "malware"},
+				"authentication":    map[string]interface{}{"token": "token"},
+			},
+			invalid: map[string]interface{}{
+				"hostname":           "test.netskope.com",
+				"content_type":       "events",
+				"content_categories": []interface{}{"application"},
+			},
+		},
+		{
+			spec: feedTypePaloAltoCortexDataLake,
+			details: map[string]interface{}{
+				"region": "europe",
+				"authentication": []interface{}{map[string]interface{}{
+					"client_id":     "id",
+					"client_secret": "secret",
+					"refresh_token": "token",
+				}},
+			},
+			settings: map[string]interface{}{
+				"region": "europe",
+				"authentication": map[string]interface{}{
+					"clientId":     "id",
+					"clientSecret": "secret",
+					"refreshToken": "token",
+				},
+			},
+			invalid: map[string]interface{}{"region": "mars"},
+		},
+		{
+			spec: feedTypeMimecast,
+			details: map[string]interface{}{
+				"base_url": "eu-api.mimecast.com",
+				"authentication": []interface{}{map[string]interface{}{
+					"application_id":  "50352701-a307-11ed-a8fc-0242ac120001",
+					"application_key": "application",
+					"access_key":      "access",
+					"secret_key":      "secret",
+				}},
+			},
+			settings: map[string]interface{}{
+				"hostname": "eu-api.mimecast.com",
+				"authentication": map[string]interface{}{
+					"applicationId":  "50352701-a307-11ed-a8fc-0242ac120001",
+					"applicationKey": "application",
+					"accessKey":      "access",
+					"secretKey":      "secret",
+				},
+			},
+			invalid: map[string]interface{}{
+				"base_url":                        "api.mimecast.com",
+				"authentication.0.application_id": "application",
+			},
+		},
+	}
+}
+
+func TestAccChronicleFeedNetskope_Basic(t *testing.T) {
+	displayName := "testtf" + randString(10)
+	details := `hostname = "test.goskope.com"
+				content_type = "alerts"
+				content_categories = ["dlp", "malware"]`
+	authentication := fmt.Sprintf(`token = "%s"`, randString(32))
+
+	resourceType := "chronicle_feed_netskope"
+	rootRef := fmt.Sprintf("%s.test", resourceType)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedNetworkDestroy(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedNetwork(resourceType, displayName, details, authentication),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedNetworkExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "enabled", "true"),
+					resource.TestCheckResourceAttr(rootRef, "details.0.content_categories.#", "2"),
+				),
+			},
+			{
+				ResourceName:      rootRef,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state",
+					"details.0.authentication.0.token"},
+			},
+		},
+	})
+}
+
+//nolint:unparam
+func testAccCheckChronicleFeedNetwork(resourceType, displayName, details, authentication string) string {
+	return fmt.Sprintf(
+		`resource "%s" "test" {
+			display_name = "%s"
+			enabled = true
+			namespace = "test"
+			labels = {
+				"test"="test"
+			}
+			details {
+				%s
+				authentication {
+				%s
+				}
+			}
+			}`, resourceType, displayName, details, authentication)
+}
+
+func testAccCheckChronicleFeedNetworkExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return NewNotFoundErrorf("ID for %s in state", n)
+		}
+		return nil
+	}
+}
+
+func testAccCheckChronicleFeedNetworkDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			if rs.Primary.ID != "" {
+				return fmt.Errorf("Object %q still exists", rs.Primary.ID)
+			}
+			return nil
+		}
+		return nil
+	}
+}
@@ -37,7 +37,7 @@ func TestAccChronicleFeedOktaSystemLog_Basic(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.key",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.key",
 					"details.0.authentication.0.value"},
 			},
 		},
@@ -85,7 +85,7 @@ func TestAccChronicleFeedOktaSystemLog_UpdateAuth(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.key",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.key",
 					"details.0.authentication.0.value"},
 			},
 		},
@@ -132,7 +132,7 @@ func TestAccChronicleFeedOktaSystemLog_UpdateEnabled(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.key",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.key",
 					"details.0.authentication.0.value"},
 			},
 		},
@@ -179,7 +179,7 @@ func TestAccChronicleOktaSystemLog_UpdateHostname(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.key",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.key",
 					"details.0.authentication.0.value"},
 			},
 		},
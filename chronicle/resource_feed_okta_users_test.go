@@ -38,7 +38,7 @@ func TestAccChronicleFeedOktaUsers_Basic(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.key",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.key",
 					"details.0.authentication.0.value"},
 			},
 		},
@@ -87,7 +87,7 @@ func TestAccChronicleFeedOktaUsers_UpdateAuth(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.key",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.key",
 					"details.0.authentication.0.value"},
 			},
 		},
@@ -135,7 +135,7 @@ func TestAccChronicleFeedOktaUsers_UpdateEnabled(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.key",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.key",
 					"details.0.authentication.0.value"},
 			},
 		},
@@ -183,7 +183,7 @@ func TestAccChronicleOktaUsers_UpdateHostname(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.key",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.key",
 					"details.0.authentication.0.value"},
 			},
 		},
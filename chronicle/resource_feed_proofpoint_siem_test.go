@@ -35,7 +35,7 @@ func TestAccChronicleProofpointSIEM_Basic(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.user",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.user",
 					"details.0.authentication.0.secret"},
 			},
 		},
@@ -81,7 +81,7 @@ func TestAccChronicleProofpointSIEM_UpdateAuth(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.user",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.user",
 					"details.0.authentication.0.secret"},
 			},
 		},
@@ -125,7 +125,7 @@ func TestAccChronicleProofpointSIEM_UpdateEnabled(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.user",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.user",
 					"details.0.authentication.0.secret"},
 			},
 		},
@@ -37,7 +37,7 @@ func TestAccChronicleFeedQualysVM_Basic(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.user",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.user",
 					"details.0.authentication.0.secret"},
 			},
 		},
@@ -86,7 +86,7 @@ func TestAccChronicleFeedQualysVM_UpdateAuth(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.user",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.user",
 					"details.0.authentication.0.secret"},
 			},
 		},
@@ -133,7 +133,7 @@ func TestAccChronicleFeedQualysVM_UpdateEnabled(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.user",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.user",
 					"details.0.authentication.0.secret"},
 			},
 		},
@@ -180,7 +180,7 @@ func TestAccChronicleFeedQualysVM_UpdateHostname(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.user",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.user",
 					"details.0.authentication.0.secret"},
 			},
 		},
@@ -0,0 +1,174 @@
+package chronicle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// saasFeedTypeCases are checked by TestFeedTypeCases.
+func saasFeedTypeCases() []feedTypeCase {
+	boxAppConfig := `{"boxAppSettings": {"clientID": "id", "clientSecret": "secret", "appAuth": {"publicKeyID": "kid", "privateKey": "key",
+		"passphrase": "passphrase"}}, "enterpriseID": "123"}`
+
+	return []feedTypeCase{
+		{
+			spec: feedTypeSalesforce,
+			details: map[string]interface{}{
+				"hostname": "test.my.salesforce.com",
+				"authentication": []interface{}{map[string]interface{}{
+					"client_id":   "id",
+					"user":        "chronicle@example.com",
+					"private_key": "key",
+				}},
+			},
+			settings: map[string]interface{}{
+				"hostname": "test.my.salesforce.com",
+				"authentication": map[string]interface{}{
+					"clientId":      "id",
+					"user":          "chronicle@example.com",
+					"rsCredentials": map[string]interface{}{"privateKey": "key"},
+				},
+			},
+			invalid: map[string]interface{}{"hostname": "test.salesforce.com"},
+		},
+		{
+			spec: feedTypeDuoAdmin,
+			details: map[string]interface{}{
+				"hostname":       "api-test.duosecurity.com",
+				"authentication": []interface{}{map[string]interface{}{"integration_key": "key", "secret_key": "secret"}},
+			},
+			settings: map[string]interface{}{
+				"hostname":       "api-test.duosecurity.com",
+				"authentication": map[string]interface{}{"user": "key", "secret": "secret"},
+			},
+			invalid: map[string]interface{}{"hostname": "admin-test.duosecurity.com"},
+		},
+		{
+			spec:     feedTypeSlackAudit,
+			details:  map[string]interface{}{"authentication": []interface{}{map[string]interface{}{"oauth_token": "xoxp-token"}}},
+			settings: map[string]interface{}{"authentication": map[string]interface{}{"token": "xoxp-token"}},
+		},
+		{
+			spec:    feedTypeBox,
+			details: map[string]interface{}{"authentication": []interface{}{map[string]interface{}{"app_config_json": boxAppConfig}}},
+			settings: map[string]interface{}{
+				"authentication": map[string]interface{}{
+					"clientId":     "id",
+					"clientSecret": "secret",
+					"publicKeyId":  "kid",
+					"privateKey":   "key",
+					"passphrase":   "passphrase",
+					"enterpriseId": "123",
+				},
+			},
+			invalid: map[string]interface{}{"authentication.0.app_config_json": `{"boxAppSettings": {"clientID": "id"}}`},
+		},
+		{
+			spec: feedTypeWorkday,
+			details: map[string]interface{}{
+				"hostname":       "wd5-services1.myworkday.com",
+				"authentication": []interface{}{map[string]interface{}{"user": "user", "secret": "secret"}},
+			},
+			settings: map[string]interface{}{
+				"hostname":       "wd5-services1.myworkday.com",
+				"authentication": map[string]interface{}{"user": "user", "secret": "secret"},
+			},
+			invalid: map[string]interface{}{"hostname": "wd5-services1.example.com"},
+		},
+		{
+			spec: feedTypeGitHubAudit,
+			details: map[string]interface{}{
+				"organization":   "test",
+				"authentication": []interface{}{map[string]interface{}{"token": "ghp_token"}},
+			},
+			settings: map[string]interface{}{
+				"organization":   "test",
+				"authentication": map[string]interface{}{"token": "ghp_token"},
+			},
+			invalid: map[string]interface{}{"enterprise": "test"},
+		},
+	}
+}
+
+func TestAccChronicleFeedGitHubAudit_Basic(t *testing.T) {
+	displayName := "testtf" + randString(10)
+	authentication := fmt.Sprintf(`token = "ghp_%s"`, randString(36))
+
+	resourceType := "chronicle_feed_github_audit"
+	rootRef := fmt.Sprintf("%s.test", resourceType)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedSaaSDestroy(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedSaaS(resourceType, displayName, `organization = "test"`, authentication),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedSaaSExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "enabled", "true"),
+					resource.TestCheckResourceAttr(rootRef, "details.0.organization", "test"),
+				),
+			},
+			{
+				ResourceName:      rootRef,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state",
+					"details.0.authentication.0.token"},
+			},
+		},
+	})
+}
+
+//nolint:unparam
+func testAccCheckChronicleFeedSaaS(resourceType, displayName, details, authentication string) string {
+	return fmt.Sprintf(
+		`resource "%s" "test" {
+			display_name = "%s"
+			enabled = true
+			namespace = "test"
+			labels = {
+				"test"="test"
+			}
+			details {
+				%s
+				authentication {
+				%s
+				}
+			}
+			}`, resourceType, displayName, details, authentication)
+}
+
+func testAccCheckChronicleFeedSaaSExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return NewNotFoundErrorf("ID for %s in state", n)
+		}
+		return nil
+	}
+}
+
+func testAccCheckChronicleFeedSaaSDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			if rs.Primary.ID != "" {
+				return fmt.Errorf("Object %q still exists", rs.Primary.ID)
+			}
+			return nil
+		}
+		return nil
+	}
+}
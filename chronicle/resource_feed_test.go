@@ -0,0 +1,175 @@
+package chronicle
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccChronicleFeed_Basic(t *testing.T) {
+	displayName := "test" + randString(10)
+	displayName1 := "test" + randString(10)
+	s3URI := "s3://test/"
+	s3URI1 := "s3://test1/"
+
+	rootRef := feedRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeed(displayName, s3URI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "display_name", displayName),
+					resource.TestCheckResourceAttr(rootRef, "feed_source_type", "AMAZON_S3"),
+					resource.TestCheckResourceAttr(rootRef, "log_type", "GITHUB"),
+				),
+			},
+			{
+				Config: testAccCheckChronicleFeed(displayName1, s3URI1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "display_name", displayName1),
+				),
+			},
+			{
+				ResourceName:            rootRef,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"display_name", "details_json", "sensitive_paths"},
+			},
+		},
+	})
+}
+
+func TestFlattenGenericFeedDetails(t *testing.T) {
+	readConf := &chronicle.GenericFeedConfiguration{
+		PropertyKey: "httpSettings",
+		Settings: map[string]interface{}{
+			"uri":                  "https://example.com",
+			"sourceDeletionOption": "SOURCE_DELETION_NEVER",
+			"authentication":       map[string]interface{}{"user": "user"},
+		},
+	}
+	original := `{"httpSettings": {"uri": "https://old.example.com", "authentication": {"user": "user", "secret": "secret"}}}`
+
+	details, err := flattenGenericFeedDetails(original, readConf, []string{"httpSettings.authentication.secret"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal([]byte(details), &result); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	expected := map[string]interface{}{
+		"httpSettings": map[string]interface{}{
+			"uri":            "https://example.com",
+			"authentication": map[string]interface{}{"user": "user", "secret": "secret"},
+		},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestFlattenGenericFeedDetails_Lists(t *testing.T) {
+	readConf := &chronicle.GenericFeedConfiguration{
+		PropertyKey: "httpsSettings",
+		Settings: map[string]interface{}{
+			"authentication": map[string]interface{}{
+				"headerKeyValues": []interface{}{
+					map[string]interface{}{"key": "Authorization", "encoding": "PLAIN"},
+					map[string]interface{}{"key": "X-Tenant", "value": "tenant", "encoding": "PLAIN"},
+				},
+			},
+		},
+	}
+	original := `{"httpsSettings": {"authentication": {"headerKeyValues": [
+		{"key": "Authorization", "value": "secret"}, {"key": "X-Tenant", "value": "tenant"}]}}}`
+
+	details, err := flattenGenericFeedDetails(original, readConf, []string{"httpsSettings.authentication.headerKeyValues.0.value"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal([]byte(details), &result); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	var expected map[string]interface{}
+	if err := json.Unmarshal([]byte(original), &expected); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func testAccCheckChronicleFeed(displayName, s3URI string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_feed" "test" {
+			display_name     = "%s"
+			feed_source_type = "AMAZON_S3"
+			log_type         = "GITHUB"
+			enabled          = true
+			details_json = jsonencode({
+				amazonS3Settings = {
+					s3Uri                = "%s"
+					sourceType           = "FILES"
+					sourceDeletionOption = "SOURCE_DELETION_NEVER"
+					authentication = {
+						region          = "EU_WEST_1"
+						accessKeyId     = "XXXXXXXXXXXXXXXXXXXX"
+						secretAccessKey = "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+					}
+				}
+			})
+			sensitive_paths = [
+				"amazonS3Settings.sourceDeletionOption",
+				"amazonS3Settings.authentication.accessKeyId",
+				"amazonS3Settings.authentication.secretAccessKey",
+			]
+		}`, displayName, s3URI)
+}
+
+func testAccCheckChronicleFeedExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return NewNotFoundErrorf("ID for %s in state", n)
+		}
+		return nil
+	}
+}
+
+func testAccCheckChronicleFeedDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "chronicle_feed.test" {
+			continue
+		}
+
+		if rs.Primary.ID != "" {
+			return fmt.Errorf("Object %q still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+	return nil
+}
+
+//nolint:all
+func feedRef(name string) string {
+	return fmt.Sprintf("chronicle_feed.%v", name)
+}
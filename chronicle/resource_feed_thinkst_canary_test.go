@@ -37,7 +37,7 @@ func TestAccChronicleFeedThinkstCanary_Basic(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.key",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.key",
 					"details.0.authentication.0.value"},
 			},
 		},
@@ -84,7 +84,7 @@ func TestAccChronicleFeedThinkstCanary_UpdateAuth(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.key",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.key",
 					"details.0.authentication.0.value"},
 			},
 		},
@@ -131,7 +131,7 @@ func TestAccChronicleFeedThinkstCanary_UpdateEnabled(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.key",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.key",
 					"details.0.authentication.0.value"},
 			},
 		},
@@ -178,7 +178,7 @@ func TestAccChronicleThinkstCanary_UpdateHostname(t *testing.T) {
 				ResourceName:      rootRef,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{"display_name", "state", "details.0.authentication.0.key",
+				ImportStateVerifyIgnore: []string{"display_name", "secret_hashes", "secret_version", "state", "details.0.authentication.0.key",
 					"details.0.authentication.0.value"},
 			},
 		},
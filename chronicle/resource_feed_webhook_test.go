@@ -0,0 +1,129 @@
+package chronicle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccChronicleFeedWebhook_Basic(t *testing.T) {
+	displayName := "test" + randString(10)
+	logType := "GITHUB"
+	enabled := "true"
+	namespace := "test"
+	labels := `"test"="test"`
+	splitDelimiter := `\n`
+
+	rootRef := feedWebhookRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedWebhook(displayName, logType, enabled, namespace, labels, splitDelimiter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedWebhookExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "log_type", logType),
+					resource.TestCheckResourceAttr(rootRef, "enabled", enabled),
+					resource.TestCheckResourceAttr(rootRef, "namespace", namespace),
+					resource.TestCheckResourceAttr(rootRef, "details.0.split_delimiter", "\n"),
+					resource.TestCheckResourceAttrSet(rootRef, "secret"),
+				),
+			},
+			{
+				ResourceName:            rootRef,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"display_name", "state", "generate_secret", "secret"},
+			},
+		},
+	})
+}
+
+func TestAccChronicleFeedWebhook_UpdateEnabled(t *testing.T) {
+	displayName := "test" + randString(10)
+	logType := "GITHUB"
+	enabled := "true"
+	notEnabled := "false"
+	namespace := "test"
+	labels := `"test"="test"`
+	splitDelimiter := `\n`
+
+	rootRef := feedWebhookRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleFeedWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleFeedWebhook(displayName, logType, enabled, namespace, labels, splitDelimiter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedWebhookExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "enabled", enabled),
+				),
+			},
+			{
+				Config: testAccCheckChronicleFeedWebhook(displayName, logType, notEnabled, namespace, labels, splitDelimiter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleFeedWebhookExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "enabled", notEnabled),
+					resource.TestCheckResourceAttrSet(rootRef, "secret"),
+				),
+			},
+		},
+	})
+}
+
+//nolint:unparam
+func testAccCheckChronicleFeedWebhook(displayName, logType, enabled, namespace, labels, splitDelimiter string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_feed_webhook" "test" {
+			display_name = "%s"
+			log_type = "%s"
+			enabled = %s
+			namespace = "%s"
+			labels = {
+				%s
+			}
+			details {
+				split_delimiter = "%s"
+			}
+			}`, displayName, logType, enabled, namespace, labels, splitDelimiter)
+}
+
+func testAccCheckChronicleFeedWebhookExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return NewNotFoundErrorf("ID for %s in state", n)
+		}
+		return nil
+	}
+}
+
+func testAccCheckChronicleFeedWebhookDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "chronicle_feed_webhook.test" {
+			continue
+		}
+
+		if rs.Primary.ID != "" {
+			return fmt.Errorf("Object %q still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+	return nil
+}
+
+//nolint:unparam
+func feedWebhookRef(name string) string {
+	return fmt.Sprintf("chronicle_feed_webhook.%v", name)
+}
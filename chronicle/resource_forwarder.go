@@ -0,0 +1,282 @@
+package chronicle
+
+import (
+	"fmt"
+	"log"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var forwarderUpdateMaskFields = map[string]string{
+	"display_name":       "display_name",
+	"upload_compression": "config.upload_compression",
+	"namespace":          "config.metadata",
+	"labels":             "config.metadata",
+	"regex_filter":       "config.regex_filters",
+	"server_settings":    "config.server_settings",
+}
+
+func resourceForwarder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceForwarderCreate,
+		Read:   resourceForwarderRead,
+		Update: resourceForwarderUpdate,
+		Delete: resourceForwarderDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(FiveMinutesTimeout),
+			Update: schema.DefaultTimeout(FiveMinutesTimeout),
+			Read:   schema.DefaultTimeout(FiveMinutesTimeout),
+			Delete: schema.DefaultTimeout(FiveMinutesTimeout),
+		},
+
+		Description: `Creates a forwarder. Collectors are attached to it with chronicle_forwarder_collector.`,
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Display name of the forwarder.`,
+			},
+			"upload_compression": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `Whether the forwarder compresses the batches it uploads to Chronicle.`,
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The namespace applied to the events of every collector of the forwarder.`,
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: `Labels applied to the events of every collector of the forwarder.`,
+			},
+			"regex_filter": forwarderRegexFilterSchema(),
+			"server_settings": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: `Settings of the HTTP server exposed by the forwarder, used for health checks.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          ForwarderStateActive,
+							ValidateDiagFunc: validateForwarderState,
+							Description:      `Whether the server is ACTIVE or SUSPENDED.`,
+						},
+						"graceful_timeout": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `Seconds after which the forwarder reports itself unhealthy when shutting down.`,
+						},
+						"drain_timeout": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `Seconds the forwarder waits for active connections to close on its own before closing them.`,
+						},
+						"http_settings": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: `HTTP listener settings.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"port": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: `Port the server listens on.`,
+									},
+									"host": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `IP address or hostname the server listens on.`,
+									},
+									"read_timeout": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: `Maximum seconds allowed to read an entire request.`,
+									},
+									"read_header_timeout": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: `Maximum seconds allowed to read the request headers.`,
+									},
+									"write_timeout": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: `Maximum seconds allowed to send a response.`,
+									},
+									"idle_timeout": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: `Maximum seconds to wait for the next request when keep-alives are enabled.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `State of the forwarder.`,
+			},
+		},
+	}
+}
+
+func resourceForwarderCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	forwarder := expandForwarder(d)
+
+	log.Printf("[DEBUG] Creating new Forwarder: %#v", forwarder)
+	id, err := client.CreateForwarder(forwarder)
+	if err != nil {
+		return fmt.Errorf("error creating Forwarder: %s", err)
+	}
+
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating Forwarder %q", d.Id())
+
+	return resourceForwarderRead(d, meta)
+}
+
+func resourceForwarderRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	forwarder, err := client.GetForwarder(d.Id())
+	if err != nil {
+		return HandleNotFoundError(err, d, d.Id())
+	}
+
+	if err := d.Set("display_name", forwarder.DisplayName); err != nil {
+		return fmt.Errorf("error reading DisplayName: %s", err)
+	}
+	if err := d.Set("upload_compression", forwarder.Config.UploadCompression); err != nil {
+		return fmt.Errorf("error reading UploadCompression: %s", err)
+	}
+	if err := flattenForwarderMetadata(d, forwarder.Config.Metadata); err != nil {
+		return fmt.Errorf("error reading Metadata: %s", err)
+	}
+	if err := d.Set("regex_filter", flattenForwarderRegexFilters(forwarder.Config.RegexFilters)); err != nil {
+		return fmt.Errorf("error reading RegexFilters: %s", err)
+	}
+	if err := d.Set("server_settings", flattenForwarderServerSettings(forwarder.Config.ServerSettings)); err != nil {
+		return fmt.Errorf("error reading ServerSettings: %s", err)
+	}
+	if err := d.Set("state", forwarder.State); err != nil {
+		return fmt.Errorf("error reading State: %s", err)
+	}
+
+	log.Printf("[DEBUG] Finished reading Forwarder %q: %#v", d.Id(), forwarder)
+
+	return nil
+}
+
+func resourceForwarderUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	updateMask := forwarderUpdateMask(d, forwarderUpdateMaskFields)
+	if len(updateMask) > 0 {
+		forwarder := expandForwarder(d)
+
+		err := client.UpdateForwarder(d.Id(), forwarder, updateMask)
+		if err != nil {
+			return fmt.Errorf("error updating Forwarder %q: %s", d.Id(), err)
+		}
+
+		log.Printf("[DEBUG] Finished updating Forwarder %q: %#v", d.Id(), forwarder)
+	}
+
+	return resourceForwarderRead(d, meta)
+}
+
+func resourceForwarderDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	log.Printf("[DEBUG] Deleting Forwarder: %#v", d.Id())
+	err := client.DeleteForwarder(d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d, "Forwarder")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Forwarder %q", d.Id())
+
+	return nil
+}
+
+func expandForwarder(d *schema.ResourceData) chronicle.Forwarder {
+	return chronicle.Forwarder{
+		DisplayName: readStringFromResource(d, "display_name"),
+		Config: chronicle.ForwarderConfig{
+			UploadCompression: readBoolFromResource(d, "upload_compression"),
+			Metadata:          expandForwarderMetadata(d),
+			RegexFilters:      expandForwarderRegexFilters(d),
+			ServerSettings:    expandForwarderServerSettings(d),
+		},
+	}
+}
+
+func expandForwarderServerSettings(d *schema.ResourceData) *chronicle.ForwarderServerSettings {
+	settings := readSingleBlockFromResource(d, "server_settings")
+	if settings == nil {
+		return nil
+	}
+
+	serverSettings := &chronicle.ForwarderServerSettings{
+		State:           settings["state"].(string),
+		GracefulTimeout: settings["graceful_timeout"].(int),
+		DrainTimeout:    settings["drain_timeout"].(int),
+	}
+
+	if httpRaw := settings["http_settings"].([]interface{}); len(httpRaw) > 0 && httpRaw[0] != nil {
+		http := httpRaw[0].(map[string]interface{})
+		serverSettings.HTTPSettings = &chronicle.ForwarderHTTPSettings{
+			Port:              http["port"].(int),
+			Host:              http["host"].(string),
+			ReadTimeout:       http["read_timeout"].(int),
+			ReadHeaderTimeout: http["read_header_timeout"].(int),
+			WriteTimeout:      http["write_timeout"].(int),
+			IdleTimeout:       http["idle_timeout"].(int),
+		}
+	}
+
+	return serverSettings
+}
+
+func flattenForwarderServerSettings(settings *chronicle.ForwarderServerSettings) []map[string]interface{} {
+	if settings == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{
+		"state":            settings.State,
+		"graceful_timeout": settings.GracefulTimeout,
+		"drain_timeout":    settings.DrainTimeout,
+	}
+
+	if http := settings.HTTPSettings; http != nil {
+		result["http_settings"] = []map[string]interface{}{{
+			"port":                http.Port,
+			"host":                http.Host,
+			"read_timeout":        http.ReadTimeout,
+			"read_header_timeout": http.ReadHeaderTimeout,
+			"write_timeout":       http.WriteTimeout,
+			"idle_timeout":        http.IdleTimeout,
+		}}
+	}
+
+	return []map[string]interface{}{result}
+}
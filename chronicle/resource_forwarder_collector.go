@@ -0,0 +1,573 @@
+package chronicle
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ForwarderCollectorSyslogProtocolTCP = "TCP"
+	ForwarderCollectorSyslogProtocolUDP = "UDP"
+)
+
+var forwarderCollectorSettings = []string{"file_settings", "syslog_settings", "splunk_settings", "pcap_settings"}
+
+var forwarderCollectorUpdateMaskFields = map[string]string{
+	"display_name":          "display_name",
+	"log_type":              "config.log_type",
+	"namespace":             "config.metadata",
+	"labels":                "config.metadata",
+	"regex_filter":          "config.regex_filters",
+	"disk_buffer":           "config.disk_buffer",
+	"max_seconds_per_batch": "config.max_seconds_per_batch",
+	"max_bytes_per_batch":   "config.max_bytes_per_batch",
+	"file_settings":         "config.file_settings",
+	"syslog_settings":       "config.syslog_settings",
+	"splunk_settings":       "config.splunk_settings",
+	"pcap_settings":         "config.pcap_settings",
+}
+
+func resourceForwarderCollector() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceForwarderCollectorCreate,
+		Read:   resourceForwarderCollectorRead,
+		Update: resourceForwarderCollectorUpdate,
+		Delete: resourceForwarderCollectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(FiveMinutesTimeout),
+			Update: schema.DefaultTimeout(FiveMinutesTimeout),
+			Read:   schema.DefaultTimeout(FiveMinutesTimeout),
+			Delete: schema.DefaultTimeout(FiveMinutesTimeout),
+		},
+
+		Description: `Creates a collector in a forwarder. Exactly one of the file, syslog, splunk or pcap settings must be set.
+The ID of the collector has the format {forwarder_id}/{collector_id}.`,
+
+		Schema: map[string]*schema.Schema{
+			"forwarder_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `ID of the forwarder the collector belongs to.`,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Display name of the collector.`,
+			},
+			"log_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Log type of the data ingested by the collector.`,
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The namespace applied to the events of the collector, overriding the forwarder one.`,
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: `Labels applied to the events of the collector.`,
+			},
+			"regex_filter": forwarderRegexFilterSchema(),
+			"disk_buffer": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: `Buffer the collected data to disk instead of memory.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          ForwarderStateActive,
+							ValidateDiagFunc: validateForwarderState,
+							Description:      `Whether disk buffering is ACTIVE or SUSPENDED.`,
+						},
+						"directory_path": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Directory where the buffer files are written.`,
+						},
+						"max_file_buffer_bytes": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `Maximum size of the buffer in bytes.`,
+						},
+					},
+				},
+			},
+			"max_seconds_per_batch": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: `Number of seconds between batches.`,
+			},
+			"max_bytes_per_batch": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: `Maximum number of bytes in a batch.`,
+			},
+			"file_settings": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: forwarderCollectorSettings,
+				Description:  `Collect logs from a file.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file_path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Path of the file to monitor.`,
+						},
+					},
+				},
+			},
+			"syslog_settings": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: forwarderCollectorSettings,
+				Description:  `Receive logs over syslog.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateForwarderCollectorSyslogProtocol,
+							Description:      `Protocol the collector listens on, TCP or UDP.`,
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Address the collector listens on.`,
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: `Port the collector listens on.`,
+						},
+						"buffer_size": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `Size in bytes of the TCP socket buffer.`,
+						},
+						"connection_timeout": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `Seconds of inactivity after which a TCP connection is closed.`,
+						},
+						"certificate": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Path of the TLS certificate used when receiving over TCP.`,
+						},
+						"certificate_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: `Path of the TLS certificate key used when receiving over TCP.`,
+						},
+						"minimum_tls_version": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Minimum TLS version accepted, e.g. TLSv1_2.`,
+						},
+					},
+				},
+			},
+			"splunk_settings": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: forwarderCollectorSettings,
+				Description:  `Collect logs from a Splunk instance.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Hostname or IP address of the Splunk REST API.`,
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: `Port of the Splunk REST API.`,
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Username used to authenticate against Splunk.`,
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: `Password used to authenticate against Splunk.`,
+						},
+						"minimum_window_size": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `Minimum time range in seconds of a Splunk query.`,
+						},
+						"maximum_window_size": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `Maximum time range in seconds of a Splunk query.`,
+						},
+						"query_string": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Query used to filter the Splunk records.`,
+						},
+						"query_mode": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Query mode of the Splunk search.`,
+						},
+						"cert_ignored": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: `Whether the certificate of the Splunk instance is not verified.`,
+						},
+					},
+				},
+			},
+			"pcap_settings": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: forwarderCollectorSettings,
+				Description:  `Capture network traffic.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_interface": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Network interface to capture traffic from.`,
+						},
+						"bpf": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Berkeley Packet Filter applied to the captured traffic.`,
+						},
+					},
+				},
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `State of the collector.`,
+			},
+		},
+	}
+}
+
+func resourceForwarderCollectorCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	forwarderID := readStringFromResource(d, "forwarder_id")
+	collector := expandForwarderCollector(d)
+
+	log.Printf("[DEBUG] Creating new Collector: %#v", collector)
+	id, err := client.CreateCollector(forwarderID, collector)
+	if err != nil {
+		return fmt.Errorf("error creating Collector: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", forwarderID, id))
+
+	log.Printf("[DEBUG] Finished creating Collector %q", d.Id())
+
+	return resourceForwarderCollectorRead(d, meta)
+}
+
+func resourceForwarderCollectorRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	forwarderID, id, err := parseForwarderCollectorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	collector, err := client.GetCollector(forwarderID, id)
+	if err != nil {
+		return HandleNotFoundError(err, d, d.Id())
+	}
+
+	if err := d.Set("forwarder_id", forwarderID); err != nil {
+		return fmt.Errorf("error reading ForwarderID: %s", err)
+	}
+	if err := d.Set("display_name", collector.DisplayName); err != nil {
+		return fmt.Errorf("error reading DisplayName: %s", err)
+	}
+	if err := d.Set("log_type", collector.Config.LogType); err != nil {
+		return fmt.Errorf("error reading LogType: %s", err)
+	}
+	if err := flattenForwarderMetadata(d, collector.Config.Metadata); err != nil {
+		return fmt.Errorf("error reading Metadata: %s", err)
+	}
+	if err := d.Set("regex_filter", flattenForwarderRegexFilters(collector.Config.RegexFilters)); err != nil {
+		return fmt.Errorf("error reading RegexFilters: %s", err)
+	}
+	if err := d.Set("disk_buffer", flattenForwarderCollectorDiskBuffer(collector.Config.DiskBuffer)); err != nil {
+		return fmt.Errorf("error reading DiskBuffer: %s", err)
+	}
+	if err := d.Set("max_seconds_per_batch", collector.Config.MaxSecondsPerBatch); err != nil {
+		return fmt.Errorf("error reading MaxSecondsPerBatch: %s", err)
+	}
+	if err := d.Set("max_bytes_per_batch", collector.Config.MaxBytesPerBatch); err != nil {
+		return fmt.Errorf("error reading MaxBytesPerBatch: %s", err)
+	}
+	if err := d.Set("file_settings", flattenForwarderCollectorFileSettings(collector.Config.FileSettings)); err != nil {
+		return fmt.Errorf("error reading FileSettings: %s", err)
+	}
+	if err := d.Set("syslog_settings", flattenForwarderCollectorSyslogSettings(d, collector.Config.SyslogSettings)); err != nil {
+		return fmt.Errorf("error reading SyslogSettings: %s", err)
+	}
+	if err := d.Set("splunk_settings", flattenForwarderCollectorSplunkSettings(d, collector.Config.SplunkSettings)); err != nil {
+		return fmt.Errorf("error reading SplunkSettings: %s", err)
+	}
+	if err := d.Set("pcap_settings", flattenForwarderCollectorPcapSettings(collector.Config.PcapSettings)); err != nil {
+		return fmt.Errorf("error reading PcapSettings: %s", err)
+	}
+	if err := d.Set("state", collector.State); err != nil {
+		return fmt.Errorf("error reading State: %s", err)
+	}
+
+	log.Printf("[DEBUG] Finished reading Collector %q", d.Id())
+
+	return nil
+}
+
+func resourceForwarderCollectorUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	forwarderID, id, err := parseForwarderCollectorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	updateMask := forwarderUpdateMask(d, forwarderCollectorUpdateMaskFields)
+	if len(updateMask) > 0 {
+		collector := expandForwarderCollector(d)
+
+		err := client.UpdateCollector(forwarderID, id, collector, updateMask)
+		if err != nil {
+			return fmt.Errorf("error updating Collector %q: %s", d.Id(), err)
+		}
+
+		log.Printf("[DEBUG] Finished updating Collector %q", d.Id())
+	}
+
+	return resourceForwarderCollectorRead(d, meta)
+}
+
+func resourceForwarderCollectorDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*chronicle.Client)
+
+	forwarderID, id, err := parseForwarderCollectorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Collector: %#v", d.Id())
+	err = client.DeleteCollector(forwarderID, id)
+	if err != nil {
+		return handleNotFoundError(err, d, "Collector")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Collector %q", d.Id())
+
+	return nil
+}
+
+func parseForwarderCollectorID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("collector ID %q not valid, expected format {forwarder_id}/{collector_id}", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandForwarderCollector(d *schema.ResourceData) chronicle.Collector {
+	return chronicle.Collector{
+		DisplayName: readStringFromResource(d, "display_name"),
+		Config: chronicle.CollectorConfig{
+			LogType:            readStringFromResource(d, "log_type"),
+			Metadata:           expandForwarderMetadata(d),
+			RegexFilters:       expandForwarderRegexFilters(d),
+			DiskBuffer:         expandForwarderCollectorDiskBuffer(d),
+			MaxSecondsPerBatch: d.Get("max_seconds_per_batch").(int),
+			MaxBytesPerBatch:   int64(d.Get("max_bytes_per_batch").(int)),
+			FileSettings:       expandForwarderCollectorFileSettings(d),
+			SyslogSettings:     expandForwarderCollectorSyslogSettings(d),
+			SplunkSettings:     expandForwarderCollectorSplunkSettings(d),
+			PcapSettings:       expandForwarderCollectorPcapSettings(d),
+		},
+	}
+}
+
+func expandForwarderCollectorDiskBuffer(d *schema.ResourceData) *chronicle.CollectorDiskBuffer {
+	block := readSingleBlockFromResource(d, "disk_buffer")
+	if block == nil {
+		return nil
+	}
+
+	return &chronicle.CollectorDiskBuffer{
+		State:              block["state"].(string),
+		DirectoryPath:      block["directory_path"].(string),
+		MaxFileBufferBytes: int64(block["max_file_buffer_bytes"].(int)),
+	}
+}
+
+func expandForwarderCollectorFileSettings(d *schema.ResourceData) *chronicle.CollectorFileSettings {
+	block := readSingleBlockFromResource(d, "file_settings")
+	if block == nil {
+		return nil
+	}
+
+	return &chronicle.CollectorFileSettings{
+		FilePath: block["file_path"].(string),
+	}
+}
+
+func expandForwarderCollectorSyslogSettings(d *schema.ResourceData) *chronicle.CollectorSyslogSettings {
+	block := readSingleBlockFromResource(d, "syslog_settings")
+	if block == nil {
+		return nil
+	}
+
+	return &chronicle.CollectorSyslogSettings{
+		Protocol:          block["protocol"].(string),
+		Address:           block["address"].(string),
+		Port:              block["port"].(int),
+		BufferSize:        int64(block["buffer_size"].(int)),
+		ConnectionTimeout: block["connection_timeout"].(int),
+		Certificate:       block["certificate"].(string),
+		CertificateKey:    block["certificate_key"].(string),
+		MinimumTLSVersion: block["minimum_tls_version"].(string),
+	}
+}
+
+func expandForwarderCollectorSplunkSettings(d *schema.ResourceData) *chronicle.CollectorSplunkSettings {
+	block := readSingleBlockFromResource(d, "splunk_settings")
+	if block == nil {
+		return nil
+	}
+
+	return &chronicle.CollectorSplunkSettings{
+		Host:              block["host"].(string),
+		Port:              block["port"].(int),
+		Username:          block["username"].(string),
+		Password:          block["password"].(string),
+		MinimumWindowSize: block["minimum_window_size"].(int),
+		MaximumWindowSize: block["maximum_window_size"].(int),
+		QueryString:       block["query_string"].(string),
+		QueryMode:         block["query_mode"].(string),
+		CertIgnored:       block["cert_ignored"].(bool),
+	}
+}
+
+func expandForwarderCollectorPcapSettings(d *schema.ResourceData) *chronicle.CollectorPcapSettings {
+	block := readSingleBlockFromResource(d, "pcap_settings")
+	if block == nil {
+		return nil
+	}
+
+	return &chronicle.CollectorPcapSettings{
+		NetworkInterface: block["network_interface"].(string),
+		BPF:              block["bpf"].(string),
+	}
+}
+
+func flattenForwarderCollectorDiskBuffer(diskBuffer *chronicle.CollectorDiskBuffer) []map[string]interface{} {
+	if diskBuffer == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"state":                 diskBuffer.State,
+		"directory_path":        diskBuffer.DirectoryPath,
+		"max_file_buffer_bytes": diskBuffer.MaxFileBufferBytes,
+	}}
+}
+
+func flattenForwarderCollectorFileSettings(settings *chronicle.CollectorFileSettings) []map[string]interface{} {
+	if settings == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"file_path": settings.FilePath,
+	}}
+}
+
+// The API does not return the certificate key, so the configured one is kept.
+func flattenForwarderCollectorSyslogSettings(d *schema.ResourceData, settings *chronicle.CollectorSyslogSettings) []map[string]interface{} {
+	if settings == nil {
+		return nil
+	}
+
+	certificateKey := settings.CertificateKey
+	if certificateKey == "" {
+		certificateKey = readStringFromResource(d, "syslog_settings.0.certificate_key")
+	}
+
+	return []map[string]interface{}{{
+		"protocol":            settings.Protocol,
+		"address":             settings.Address,
+		"port":                settings.Port,
+		"buffer_size":         settings.BufferSize,
+		"connection_timeout":  settings.ConnectionTimeout,
+		"certificate":         settings.Certificate,
+		"certificate_key":     certificateKey,
+		"minimum_tls_version": settings.MinimumTLSVersion,
+	}}
+}
+
+// The API does not return the password, so the configured one is kept.
+func flattenForwarderCollectorSplunkSettings(d *schema.ResourceData, settings *chronicle.CollectorSplunkSettings) []map[string]interface{} {
+	if settings == nil {
+		return nil
+	}
+
+	password := settings.Password
+	if password == "" {
+		password = readStringFromResource(d, "splunk_settings.0.password")
+	}
+
+	return []map[string]interface{}{{
+		"host":                settings.Host,
+		"port":                settings.Port,
+		"username":            settings.Username,
+		"password":            password,
+		"minimum_window_size": settings.MinimumWindowSize,
+		"maximum_window_size": settings.MaximumWindowSize,
+		"query_string":        settings.QueryString,
+		"query_mode":          settings.QueryMode,
+		"cert_ignored":        settings.CertIgnored,
+	}}
+}
+
+func flattenForwarderCollectorPcapSettings(settings *chronicle.CollectorPcapSettings) []map[string]interface{} {
+	if settings == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"network_interface": settings.NetworkInterface,
+		"bpf":               settings.BPF,
+	}}
+}
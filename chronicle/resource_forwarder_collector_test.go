@@ -0,0 +1,160 @@
+package chronicle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccChronicleForwarderCollector_Syslog(t *testing.T) {
+	t.Parallel()
+	forwarderName := fmt.Sprintf("test%s", randString(10))
+	displayName := fmt.Sprintf("test%s", randString(10))
+
+	rootRef := forwarderCollectorRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleForwarderCollectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleForwarderCollectorSyslog(forwarderName, displayName, "TCP", "10514"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleForwarderCollectorExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "display_name", displayName),
+					resource.TestCheckResourceAttr(rootRef, "log_type", "PAN_FIREWALL"),
+					resource.TestCheckResourceAttr(rootRef, "max_bytes_per_batch", "1048576"),
+					resource.TestCheckResourceAttr(rootRef, "syslog_settings.0.protocol", "TCP"),
+					resource.TestCheckResourceAttr(rootRef, "syslog_settings.0.port", "10514"),
+				),
+			},
+			{
+				Config: testAccCheckChronicleForwarderCollectorSyslog(forwarderName, displayName, "UDP", "10515"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleForwarderCollectorExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "syslog_settings.0.protocol", "UDP"),
+					resource.TestCheckResourceAttr(rootRef, "syslog_settings.0.port", "10515"),
+				),
+			},
+			{
+				ResourceName:      rootRef,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccChronicleForwarderCollector_Splunk(t *testing.T) {
+	t.Parallel()
+	forwarderName := fmt.Sprintf("test%s", randString(10))
+	displayName := fmt.Sprintf("test%s", randString(10))
+	password := randString(16)
+
+	rootRef := forwarderCollectorRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleForwarderCollectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleForwarderCollectorSplunk(forwarderName, displayName, password),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleForwarderCollectorExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "splunk_settings.0.host", "splunk.example.com"),
+					resource.TestCheckResourceAttr(rootRef, "splunk_settings.0.password", password),
+				),
+			},
+			{
+				ResourceName:      rootRef,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"splunk_settings.0.password",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckChronicleForwarderCollectorSyslog(forwarderName, displayName, protocol, port string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_forwarder" "test" {
+			display_name = "%s"
+		}
+
+		resource "chronicle_forwarder_collector" "test" {
+			forwarder_id          = chronicle_forwarder.test.id
+			display_name          = "%s"
+			log_type              = "PAN_FIREWALL"
+			max_seconds_per_batch = 10
+			max_bytes_per_batch   = 1048576
+			disk_buffer {
+				directory_path        = "/var/lib/chronicle"
+				max_file_buffer_bytes = 1073741824
+			}
+			syslog_settings {
+				protocol           = "%s"
+				address            = "0.0.0.0"
+				port               = %s
+				buffer_size        = 65536
+				connection_timeout = 60
+			}
+		}`, forwarderName, displayName, protocol, port)
+}
+
+func testAccCheckChronicleForwarderCollectorSplunk(forwarderName, displayName, password string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_forwarder" "test" {
+			display_name = "%s"
+		}
+
+		resource "chronicle_forwarder_collector" "test" {
+			forwarder_id = chronicle_forwarder.test.id
+			display_name = "%s"
+			log_type     = "WINDOWS_DNS"
+			splunk_settings {
+				host         = "splunk.example.com"
+				port         = 8089
+				username     = "chronicle"
+				password     = "%s"
+				query_string = "search index=dns"
+			}
+		}`, forwarderName, displayName, password)
+}
+
+func testAccCheckChronicleForwarderCollectorExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return NewNotFoundErrorf("ID for %s in state", n)
+		}
+		return nil
+	}
+}
+
+func testAccCheckChronicleForwarderCollectorDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "chronicle_forwarder_collector.test" {
+			continue
+		}
+
+		if rs.Primary.ID != "" {
+			return fmt.Errorf("Object %q still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+	return nil
+}
+
+//nolint:all
+func forwarderCollectorRef(name string) string {
+	return fmt.Sprintf("chronicle_forwarder_collector.%v", name)
+}
@@ -0,0 +1,137 @@
+package chronicle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccChronicleForwarder_Basic(t *testing.T) {
+	t.Parallel()
+	displayName := fmt.Sprintf("test%s", randString(10))
+	namespace := "test"
+	labels := `"test"="test"`
+
+	rootRef := forwarderRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleForwarderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleForwarder(displayName, namespace, labels, "BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleForwarderExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "display_name", displayName),
+					resource.TestCheckResourceAttr(rootRef, "namespace", namespace),
+					resource.TestCheckResourceAttr(rootRef, "regex_filter.0.behavior", "BLOCK"),
+					resource.TestCheckResourceAttr(rootRef, "server_settings.0.http_settings.0.port", "8080"),
+				),
+			},
+			{
+				ResourceName:      rootRef,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccChronicleForwarder_UpdateRegexFilter(t *testing.T) {
+	t.Parallel()
+	displayName := fmt.Sprintf("test%s", randString(10))
+	displayName1 := fmt.Sprintf("test%s", randString(10))
+	namespace := "test"
+	labels := `"test"="test"`
+
+	rootRef := forwarderRef("test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckChronicleForwarderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChronicleForwarder(displayName, namespace, labels, "BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleForwarderExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "display_name", displayName),
+					resource.TestCheckResourceAttr(rootRef, "regex_filter.0.behavior", "BLOCK"),
+				),
+			},
+			{
+				Config: testAccCheckChronicleForwarder(displayName1, namespace, labels, "ALLOW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChronicleForwarderExists(rootRef),
+					resource.TestCheckResourceAttr(rootRef, "display_name", displayName1),
+					resource.TestCheckResourceAttr(rootRef, "regex_filter.0.behavior", "ALLOW"),
+				),
+			},
+			{
+				ResourceName:      rootRef,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckChronicleForwarder(displayName, namespace, labels, behavior string) string {
+	return fmt.Sprintf(
+		`resource "chronicle_forwarder" "test" {
+			display_name       = "%s"
+			upload_compression = true
+			namespace          = "%s"
+			labels = {
+				%s
+			}
+			regex_filter {
+				description = "drop debug lines"
+				regexp      = ".*DEBUG.*"
+				behavior    = "%s"
+			}
+			server_settings {
+				graceful_timeout = 15
+				drain_timeout    = 10
+				http_settings {
+					port = 8080
+					host = "0.0.0.0"
+				}
+			}
+		}`, displayName, namespace, labels, behavior)
+}
+
+func testAccCheckChronicleForwarderExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return NewNotFoundErrorf("%s in state", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return NewNotFoundErrorf("ID for %s in state", n)
+		}
+		return nil
+	}
+}
+
+func testAccCheckChronicleForwarderDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "chronicle_forwarder.test" {
+			continue
+		}
+
+		if rs.Primary.ID != "" {
+			return fmt.Errorf("Object %q still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+	return nil
+}
+
+//nolint:all
+func forwarderRef(name string) string {
+	return fmt.Sprintf("chronicle_forwarder.%v", name)
+}
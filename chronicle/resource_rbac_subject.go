@@ -31,7 +31,12 @@ func resourceRBACSubject() *schema.Resource {
 			Read:   schema.DefaultTimeout(FiveMinutesTimeout),
 		},
 
-		Description: "Creates a subject and assigns the given role.",
+		Description: "Creates a subject and assigns the given role. " +
+			"With the instance-scoped Chronicle API, the roles are bound to the subject in the IAM policy of the instance project: " +
+			"analysts are bound as users and IdP groups as groups, unless the name is already an IAM member, " +
+			"e.g. a workforce identity federation principal set. The predefined roles Administrator, Editor, Viewer and " +
+			"ViewerWithNoDetectAccess are bound as roles/chronicle.admin, roles/chronicle.editor, roles/chronicle.viewer and " +
+			"roles/chronicle.limitedViewer, other roles by their IAM name.",
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -92,6 +97,9 @@ func resourceRBACSubjectRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	roleNames := flattenRoleNames(subject.Roles)
+	if configured := readStringSliceFromResource(d, "roles"); sameIAMRoles(configured, roleNames) {
+		roleNames = configured
+	}
 
 	if err := d.Set("roles", roleNames); err != nil {
 		return fmt.Errorf("error reading Roles: %s", err)
@@ -163,6 +171,29 @@ func expandRolesFromRolesNameStringSlice(rolesNames []string) []chronicle.Role {
 	return roles
 }
 
+// sameIAMRoles reports whether both lists of roles grant the same roles, whatever their order and whether predefined
+// roles are named after the legacy RBAC API or IAM, as instances migrated to Google Cloud IAM return IAM roles.
+func sameIAMRoles(roles, other []string) bool {
+	if len(roles) != len(other) {
+		return false
+	}
+
+	iamRoles := make(map[string]int, len(roles))
+	for _, role := range roles {
+		iamRoles[chronicle.IAMRoleName(role)]++
+	}
+	for _, role := range other {
+		iamRoles[chronicle.IAMRoleName(role)]--
+	}
+	for _, count := range iamRoles {
+		if count != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
 func flattenRoleNames(roles []chronicle.Role) []string {
 	if roles == nil {
 		return nil
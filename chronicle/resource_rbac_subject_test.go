@@ -1,13 +1,120 @@
 package chronicle
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
 
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+// TestRBACSubject_InstanceIAMPolicy manages subjects of an instance against a fake IAM policy.
+func TestRBACSubject_InstanceIAMPolicy(t *testing.T) {
+	var mu sync.Mutex
+	policy := map[string]interface{}{
+		"etag": "BwX",
+		"bindings": []interface{}{
+			map[string]interface{}{"role": "roles/owner", "members": []interface{}{"user:owner@example.com"}},
+			map[string]interface{}{"role": "roles/chronicle.viewer", "members": []interface{}{"user:other@example.com"}},
+		},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if strings.HasSuffix(r.URL.Path, ":setIamPolicy") {
+			var request struct {
+				Policy map[string]interface{} `json:"policy"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			policy = request.Policy
+		}
+		_ = json.NewEncoder(w).Encode(policy)
+	}))
+	defer server.Close()
+
+	client, err := chronicle.NewClient(chronicle.RegionEurope, "test", context.Background(),
+		chronicle.WithBackstoryAPIAccessToken("token"),
+		chronicle.WithInstance(chronicle.APIVersionV1Alpha, "project", "eu", "00000000-0000-0000-0000-000000000000"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	client.WithSubjectsBasePath(server.URL + "/v1/projects/project")
+
+	subject := chronicle.Subject{
+		Name:  "analyst@example.com",
+		Type:  RBACSubjectTypeAnalyst,
+		Roles: []chronicle.Role{{Name: "Editor"}, {Name: "roles/chronicle.viewer"}},
+	}
+	if err := client.CreateSubject(subject); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	read, err := client.GetSubject(subject.Name)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if read.Type != RBACSubjectTypeAnalyst || !sameIAMRoles(flattenRoleNames(read.Roles), []string{"Editor", "Viewer"}) {
+		t.Errorf("unexpected subject %#v", read)
+	}
+
+	subject.Roles = []chronicle.Role{{Name: "Administrator"}}
+	if err := client.UpdateSubject(subject); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	read, err = client.GetSubject(subject.Name)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !reflect.DeepEqual(flattenRoleNames(read.Roles), []string{"Administrator"}) {
+		t.Errorf("unexpected roles %v", flattenRoleNames(read.Roles))
+	}
+
+	if err := client.DeleteSubject(subject.Name); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := client.GetSubject(subject.Name); !IsChronicleAPIErrorWithCode(err, http.StatusNotFound) {
+		t.Errorf("expected subject to be gone, got %v", err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"role": "roles/owner", "members": []interface{}{"user:owner@example.com"}},
+		map[string]interface{}{"role": "roles/chronicle.viewer", "members": []interface{}{"user:other@example.com"}},
+	}
+	if !reflect.DeepEqual(policy["bindings"], expected) {
+		t.Errorf("expected other bindings to be left untouched, got %v", policy["bindings"])
+	}
+}
+
+func TestSameIAMRoles(t *testing.T) {
+	cases := []struct {
+		roles, other []string
+		expected     bool
+	}{
+		{[]string{"Editor", "Viewer"}, []string{"roles/chronicle.viewer", "Editor"}, true},
+		{[]string{"Editor"}, []string{"roles/chronicle.editor"}, true},
+		{[]string{"Editor"}, []string{"Viewer"}, false},
+		{[]string{"Editor"}, []string{"Editor", "Viewer"}, false},
+	}
+
+	for _, c := range cases {
+		if actual := sameIAMRoles(c.roles, c.other); actual != c.expected {
+			t.Errorf("sameIAMRoles(%v, %v): expected %t, got %t", c.roles, c.other, c.expected, actual)
+		}
+	}
+}
+
 func TestAccChronicleRBACSubject_Basic(t *testing.T) {
 	t.Parallel()
 	name := fmt.Sprintf("test%s", randString(5))
@@ -44,7 +44,16 @@ func readStringSliceFromResource(d *schema.ResourceData, key string) []string {
 	return nil
 }
 
-//nolint:unparam
+// readSingleBlockFromResource returns the attributes of a block declared with MaxItems: 1.
+func readSingleBlockFromResource(d *schema.ResourceData, key string) map[string]interface{} {
+	blockRaw := readSliceFromResource(d, key)
+	if len(blockRaw) == 0 || blockRaw[0] == nil {
+		return nil
+	}
+
+	return blockRaw[0].(map[string]interface{})
+}
+
 func readSliceFromResource(d *schema.ResourceData, key string) []interface{} {
 	if attr, ok := d.GetOk(key); ok {
 		var slice []interface{}
@@ -1,154 +1,223 @@
 package chronicle
 
 import (
-	"fmt"
+	"context"
 	"log"
 
 	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func resourceReferenceList() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceReferenceListCreate,
-		Read:   resourceReferenceListRead,
-		Update: resourceReferenceListUpdate,
-		Delete: resourceReferenceListDelete,
+var (
+	_ resource.ResourceWithConfigure   = &referenceListResource{}
+	_ resource.ResourceWithImportState = &referenceListResource{}
+)
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type referenceListResource struct {
+	client *chronicle.Client
+}
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(FiveMinutesTimeout),
-			Update: schema.DefaultTimeout(FiveMinutesTimeout),
-			Read:   schema.DefaultTimeout(FiveMinutesTimeout),
-			Delete: schema.DefaultTimeout(FiveMinutesTimeout),
-		},
+type referenceListResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	ContentType types.String   `tfsdk:"content_type"`
+	Lines       types.List     `tfsdk:"lines"`
+	CreateTime  types.String   `tfsdk:"create_time"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
 
+func newReferenceListResource() resource.Resource {
+	return &referenceListResource{}
+}
+
+func (r *referenceListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reference_list"
+}
+
+func (r *referenceListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
 		Description: `Creates a reference list.`,
 
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: `Unique name for the list.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   `The ID of this resource.`,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
-			"description": {
-				Type:        schema.TypeString,
+			"name": schema.StringAttribute{
+				Required:      true,
+				Description:   `Unique name for the list.`,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"description": schema.StringAttribute{
 				Required:    true,
-				Optional:    false,
 				Description: `Description of the list.`,
 			},
-			"content_type": {
-				Type:             schema.TypeString,
-				Required:         false,
-				Optional:         true,
-				ForceNew:         true,
-				Default:          string(chronicle.ReferenceListContentTypeDefault),
-				ValidateDiagFunc: validateReferenceListContentType,
-				Description:      `Type of list content: "CONTENT_TYPE_DEFAULT_STRING", "REGEX", "CIDR". If omitted, defaults to "CONTENT_TYPE_DEFAULT_STRING".`,
+			"content_type": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString(string(chronicle.ReferenceListContentTypeDefault)),
+				Validators:    []validator.String{sdkStringValidator{validate: validateReferenceListContentType, description: "content type must be a reference list content type"}},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   `Type of list content: "CONTENT_TYPE_DEFAULT_STRING", "REGEX", "CIDR". If omitted, defaults to "CONTENT_TYPE_DEFAULT_STRING".`,
 			},
-			"lines": {
-				Type:        schema.TypeList,
+			"lines": schema.ListAttribute{
+				ElementType: types.StringType,
 				Required:    true,
-				Optional:    false,
 				Description: `List of line items.`,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
 			},
-			"create_time": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    false,
-				Computed:    true,
-				Description: `Create time.`,
+			"create_time": schema.StringAttribute{
+				Computed:      true,
+				Description:   `Create time.`,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 		},
+
+		// Kept so that configurations of the SDKv2 resource setting timeouts remain valid.
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *referenceListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = frameworkClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// referenceList builds the reference list sent to the API from model.
+func (r *referenceListResource) referenceList(ctx context.Context, model referenceListResourceModel, diags *diag.Diagnostics) chronicle.ReferenceList {
+	var lines []string
+	diags.Append(model.Lines.ElementsAs(ctx, &lines, false)...)
+
+	return chronicle.ReferenceList{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		ContentType: chronicle.ReferenceListContentType(model.ContentType.ValueString()),
+		Lines:       lines,
 	}
 }
 
-func resourceReferenceListCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*chronicle.Client)
+func (r *referenceListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan referenceListResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	referenceListRequest := chronicle.ReferenceList{
-		Name:        readStringFromResource(d, "name"),
-		Description: readStringFromResource(d, "description"),
-		ContentType: chronicle.ReferenceListContentType(readStringFromResource(d, "content_type")),
-		Lines:       readStringSliceFromResource(d, "lines"),
+	referenceListRequest := r.referenceList(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	id, err := client.CreateReferenceList(referenceListRequest)
+	id, err := r.client.CreateReferenceList(referenceListRequest)
 	if err != nil {
-		return fmt.Errorf("error creating Schema: %s", err)
+		resp.Diagnostics.AddError("error creating Schema", err.Error())
+		return
 	}
 
-	d.SetId(id)
+	log.Printf("[DEBUG] Finished creating Reference List %q", id)
 
-	log.Printf("[DEBUG] Finished creating Reference List %q", d.Id())
+	plan.ID = types.StringValue(id)
+	r.readReferenceList(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
 
-	return resourceReferenceListRead(d, meta)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-func resourceReferenceListRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*chronicle.Client)
-
-	referenceList, err := client.GetReferenceList(d.Id())
-	if err != nil {
-		return fmt.Errorf("error reading Schema: %s", err)
+func (r *referenceListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state referenceListResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if err := d.Set("name", referenceList.Name); err != nil {
-		return fmt.Errorf("error reading Name: %s", err)
-	}
-	if err := d.Set("description", referenceList.Description); err != nil {
-		return fmt.Errorf("error reading Description: %s", err)
-	}
-	if err := d.Set("content_type", referenceList.ContentType); err != nil {
-		return fmt.Errorf("error reading ContentType: %s", err)
+	r.readReferenceList(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if err := d.Set("lines", referenceList.Lines); err != nil {
-		return fmt.Errorf("error reading Lines: %s", err)
-	}
-	if err := d.Set("create_time", referenceList.CreateTime); err != nil {
-		return fmt.Errorf("error reading create time: %s", err)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *referenceListResource) readReferenceList(ctx context.Context, model *referenceListResourceModel, diags *diag.Diagnostics) {
+	id := model.ID.ValueString()
+	referenceList, err := r.client.GetReferenceList(id)
+	if err != nil {
+		diags.AddError("error reading Schema", err.Error())
+		return
 	}
 
-	log.Printf("[DEBUG] Finished reading Reference List %q: %#v", d.Id(), referenceList)
+	lines, d := types.ListValueFrom(ctx, types.StringType, referenceList.Lines)
+	diags.Append(d...)
 
-	return nil
+	model.Name = types.StringValue(referenceList.Name)
+	model.Description = types.StringValue(referenceList.Description)
+	model.ContentType = types.StringValue(string(referenceList.ContentType))
+	model.Lines = lines
+	model.CreateTime = types.StringValue(referenceList.CreateTime)
+
+	log.Printf("[DEBUG] Finished reading Reference List %q: %#v", id, referenceList)
 }
 
-func resourceReferenceListUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*chronicle.Client)
+func (r *referenceListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state referenceListResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	referenceList := chronicle.ReferenceList{
-		Name:        readStringFromResource(d, "name"),
-		Description: readStringFromResource(d, "description"),
-		ContentType: chronicle.ReferenceListContentType(readStringFromResource(d, "content_type")),
-		Lines:       readStringSliceFromResource(d, "lines"),
+	referenceList := r.referenceList(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	linesHasChange, descriptionHasChange := true, false
-	if d.HasChange("description") {
+	if !plan.Description.Equal(state.Description) {
 		descriptionHasChange = true
 	} else {
 		referenceList.Description = ""
 	}
 
-	_, err := client.UpdateReferenceList(referenceList, linesHasChange, descriptionHasChange)
-	if err != nil {
-		return fmt.Errorf("error updating reference list: %s", err)
+	if _, err := r.client.UpdateReferenceList(referenceList, linesHasChange, descriptionHasChange); err != nil {
+		resp.Diagnostics.AddError("error updating reference list", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	r.readReferenceList(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	return resourceReferenceListRead(d, meta)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-func resourceReferenceListDelete(d *schema.ResourceData, meta interface{}) error {
-	log.Printf("[DEBUG] Deleting Schema: %#v", d.Id())
+func (r *referenceListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state referenceListResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	log.Printf("[DEBUG] Deleting Schema: %#v", state.ID.ValueString())
 	// Delete method hasn't been implemented by Google yet.
-	log.Printf("[DEBUG] Finished deleting Reference List %q", d.Id())
+	log.Printf("[DEBUG] Finished deleting Reference List %q", state.ID.ValueString())
+}
 
-	return nil
+func (r *referenceListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -5,10 +5,38 @@ import (
 	"testing"
 
 	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestReferenceListResource_UpgradeSDKState(t *testing.T) {
+	attributes := testUpgradeSDKState(t, "chronicle_reference_list", `{
+		"id": "test",
+		"name": "test",
+		"description": "test",
+		"content_type": "CIDR",
+		"lines": ["10.0.0.0/8", "192.168.0.0/16"],
+		"create_time": "2023-01-01T00:00:00Z",
+		"timeouts": null
+	}`)
+
+	expected := map[string]tftypes.Value{
+		"id":           tftypes.NewValue(tftypes.String, "test"),
+		"content_type": tftypes.NewValue(tftypes.String, "CIDR"),
+		"lines": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "10.0.0.0/8"),
+			tftypes.NewValue(tftypes.String, "192.168.0.0/16"),
+		}),
+		"create_time": tftypes.NewValue(tftypes.String, "2023-01-01T00:00:00Z"),
+	}
+	for name, value := range expected {
+		if !attributes[name].Equal(value) {
+			t.Errorf("%s: expected %s, got %s", name, value, attributes[name])
+		}
+	}
+}
+
 func TestAccChronicleReferenceList_Basic(t *testing.T) {
 	name := fmt.Sprintf("test%s", randString(5))
 	description := "Acceptance test"
@@ -18,9 +46,9 @@ func TestAccChronicleReferenceList_Basic(t *testing.T) {
 	rootRef := referenceListRef("test")
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckChronicleReferenceListDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChronicleReferenceListDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckChronicleReferenceList(name, description, contentType, lines),
@@ -52,9 +80,9 @@ func TestAccChronicleReferenceList_Update(t *testing.T) {
 	rootRef := referenceListRef("test")
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckChronicleReferenceListDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChronicleReferenceListDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckChronicleReferenceList(name, description, contentType, lines),
@@ -95,9 +123,9 @@ func TestAccChronicleReferenceList_UpdateLines(t *testing.T) {
 	rootRef := referenceListRef("test")
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckChronicleReferenceListDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChronicleReferenceListDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckChronicleReferenceList(name, description, contentType, lines),
@@ -138,9 +166,9 @@ func TestAccChronicleReferenceList_UpdateDescription(t *testing.T) {
 	rootRef := referenceListRef("test")
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckChronicleReferenceListDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChronicleReferenceListDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckChronicleReferenceList(name, description, contentType, lines),
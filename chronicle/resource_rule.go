@@ -1,259 +1,325 @@
 package chronicle
 
 import (
-	"fmt"
+	"context"
 	"log"
 
 	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func resourceRule() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceRuleCreate,
-		Read:   resourceRuleRead,
-		Update: resourceRuleUpdate,
-		Delete: resourceRuleDelete,
+var (
+	_ resource.ResourceWithConfigure   = &ruleResource{}
+	_ resource.ResourceWithImportState = &ruleResource{}
+	_ resource.ResourceWithModifyPlan  = &ruleResource{}
+)
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type ruleResource struct {
+	client *chronicle.Client
+}
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(FiveMinutesTimeout),
-			Update: schema.DefaultTimeout(FiveMinutesTimeout),
-			Read:   schema.DefaultTimeout(FiveMinutesTimeout),
-			Delete: schema.DefaultTimeout(FiveMinutesTimeout),
-		},
+type ruleResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	RuleText          types.String   `tfsdk:"rule_text"`
+	RuleName          types.String   `tfsdk:"rule_name"`
+	VersionID         types.String   `tfsdk:"version_id"`
+	Metadata          types.Map      `tfsdk:"metadata"`
+	RuleType          types.String   `tfsdk:"rule_type"`
+	LiveEnabled       types.Bool     `tfsdk:"live_enabled"`
+	AlertingEnabled   types.Bool     `tfsdk:"alerting_enabled"`
+	VersionCreateTime types.String   `tfsdk:"version_create_time"`
+	CompilationState  types.String   `tfsdk:"compilation_state"`
+	CompilationError  types.String   `tfsdk:"compilation_error"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func newRuleResource() resource.Resource {
+	return &ruleResource{}
+}
 
+func (r *ruleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule"
+}
+
+func (r *ruleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
 		Description: `Creates a new rule and rule versions`,
 
-		Schema: map[string]*schema.Schema{
-			"rule_text": {
-				Type:             schema.TypeString,
-				Required:         true,
-				Description:      `Text of the new rule in YARA-L 2.0 format.`,
-				ValidateDiagFunc: validateRuleText,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   `The ID of this resource.`,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"rule_text": schema.StringAttribute{
+				Required:    true,
+				Description: `Text of the new rule in YARA-L 2.0 format.`,
+				Validators:  []validator.String{sdkStringValidator{validate: validateRuleText, description: "rule text must end with new line"}},
 			},
-			"rule_name": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    false,
+			"rule_name": schema.StringAttribute{
 				Computed:    true,
 				Description: `Name of the rule as parsed from "rule_text".`,
 			},
-			"version_id": {
-				Type:     schema.TypeString,
-				Required: false,
-				Optional: false,
+			"version_id": schema.StringAttribute{
 				Computed: true,
 				Description: `Unique identifier for a specific version of a rule,
 				 defined and returned by the server. You can specify exactly one version identifier.
 				  Use the following format to specify the rule: {ruleId}@v_{int64}_{int64}.`,
 			},
-			"metadata": {
-				Type:        schema.TypeMap,
-				Elem:        schema.TypeString,
-				Required:    false,
-				Optional:    false,
+			"metadata": schema.MapAttribute{
+				ElementType: types.StringType,
 				Computed:    true,
 				Description: `Metadata for the rule as parsed from "rule_text".`,
 			},
-			"rule_type": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    false,
+			"rule_type": schema.StringAttribute{
 				Computed:    true,
 				Description: `Type of rule. Can be either SINGLE_EVENT or MULTI_EVENT.`,
 			},
-			"live_enabled": {
-				Type:        schema.TypeBool,
-				Required:    false,
-				Optional:    true,
-				Computed:    true,
-				Description: `Whether the rule is enabled to run as a Live Rule.`,
+			"live_enabled": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   `Whether the rule is enabled to run as a Live Rule.`,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 			},
-			"alerting_enabled": {
-				Type:        schema.TypeBool,
-				Required:    false,
-				Optional:    true,
-				Computed:    true,
-				Description: `Whether the rule is enabled to generate alerts.`,
+			"alerting_enabled": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   `Whether the rule is enabled to generate alerts.`,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 			},
-			"version_create_time": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    false,
+			"version_create_time": schema.StringAttribute{
 				Computed:    true,
 				Description: `String representing the time in RFC 3339 format.`,
 			},
-			"compilation_state": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    false,
+			"compilation_state": schema.StringAttribute{
 				Computed:    true,
 				Description: `Compilability of the rule ("SUCCEEDED" or "FAILED)".`,
 			},
-			"compilation_error": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    false,
+			"compilation_error": schema.StringAttribute{
 				Computed:    true,
 				Description: `If "compilationState" is "FAILED", a compilation error for the rule is returned. Does not appear if "compilationState" is "SUCCEEDED".`,
 			},
 		},
+
+		// The client does not take a context, the block is kept for the configurations written for the SDKv2 resource.
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *ruleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = frameworkClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// ModifyPlan keeps the values parsed from the rule text as they are unless the text changes, which creates a new version.
+func (r *ruleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
 	}
+
+	var plan, state ruleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !plan.RuleText.Equal(state.RuleText) {
+		return
+	}
+
+	plan.RuleName = state.RuleName
+	plan.VersionID = state.VersionID
+	plan.Metadata = state.Metadata
+	plan.RuleType = state.RuleType
+	plan.VersionCreateTime = state.VersionCreateTime
+	plan.CompilationState = state.CompilationState
+	plan.CompilationError = state.CompilationError
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
 }
 
-func resourceRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*chronicle.Client)
+func (r *ruleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ruleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	ruleRequest := chronicle.Rule{
-		Text: readStringFromResource(d, "rule_text"),
+		Text: plan.RuleText.ValueString(),
 	}
 
-	if ok, err := client.VerifyYARARule(ruleRequest.Text); !ok {
-		return fmt.Errorf("error verifying YARA-L 2.0 rule: %s", err)
+	if ok, err := r.client.VerifyYARARule(ruleRequest.Text); !ok {
+		resp.Diagnostics.AddError("error verifying YARA-L 2.0 rule", err.Error())
+		return
 	}
 
-	log.Printf("[DEBUG] Creating new Schema: %#v", ruleRequest)
+	log.Printf("[DEBUG] Creating new Rule: %#v", ruleRequest)
 
-	id, err := client.CreateRule(ruleRequest)
+	id, err := r.client.CreateRule(ruleRequest)
 	if err != nil {
-		return fmt.Errorf("error creating Schema: %s", err)
+		resp.Diagnostics.AddError("error creating Rule", err.Error())
+		return
 	}
 
-	d.SetId(id)
+	// the rule exists from now on, even if enabling it fails
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 
-	log.Printf("[DEBUG] Finished creating Rule %q", d.Id())
+	log.Printf("[DEBUG] Finished creating Rule %q", id)
 
-	if readBoolFromResource(d, "alerting_enabled") {
+	if plan.AlertingEnabled.ValueBool() {
 		log.Printf("[DEBUG] Enabling alerting for rule : %#v", id)
 
-		err = client.ChangeAlertingRule(id, true)
-		if err != nil {
-			return fmt.Errorf("error enabling alerting: %s", err)
+		if err := r.client.ChangeAlertingRule(id, true); err != nil {
+			resp.Diagnostics.AddError("error enabling alerting", err.Error())
+			return
 		}
 
 		log.Printf("[DEBUG] Finished alerting for rule : %#v", id)
 	}
 
-	if readBoolFromResource(d, "live_enabled") {
+	if plan.LiveEnabled.ValueBool() {
 		log.Printf("[DEBUG] Enabling live rule for rule : %#v", id)
 
-		err = client.ChangeLiveRule(id, true)
-		if err != nil {
-			return fmt.Errorf("error enabling live rule: %s", err)
+		if err := r.client.ChangeLiveRule(id, true); err != nil {
+			resp.Diagnostics.AddError("error enabling live rule", err.Error())
+			return
 		}
 
 		log.Printf("[DEBUG] Finished enabling live rule for rule : %#v", id)
 	}
 
-	return resourceRuleRead(d, meta)
+	plan.ID = types.StringValue(id)
+	if r.readRule(ctx, &plan, &resp.Diagnostics) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	}
 }
 
-func resourceRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*chronicle.Client)
-
-	rule, err := client.GetRule(d.Id())
-	if err != nil {
-		return HandleNotFoundError(err, d, d.Id())
+func (r *ruleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ruleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if err := d.Set("rule_text", rule.Text); err != nil {
-		return fmt.Errorf("error reading Name: %s", err)
-	}
-	if err := d.Set("version_id", rule.VersionID); err != nil {
-		return fmt.Errorf("error reading Name: %s", err)
-	}
-	if err := d.Set("rule_name", rule.Name); err != nil {
-		return fmt.Errorf("error reading Name: %s", err)
-	}
-	if err := d.Set("metadata", rule.Metadata); err != nil {
-		return fmt.Errorf("error reading Name: %s", err)
-	}
-	if err := d.Set("rule_type", rule.Type); err != nil {
-		return fmt.Errorf("error reading Type: %s", err)
-	}
-	if err := d.Set("live_enabled", rule.LiveEnabled); err != nil {
-		return fmt.Errorf("error reading Roles: %s", err)
-	}
-	if err := d.Set("alerting_enabled", rule.AlertingEnabled); err != nil {
-		return fmt.Errorf("error reading Roles: %s", err)
-	}
-	if err := d.Set("version_create_time", rule.VersionCreateTime); err != nil {
-		return fmt.Errorf("error reading Roles: %s", err)
-	}
-	if err := d.Set("compilation_state", rule.CompilationState); err != nil {
-		return fmt.Errorf("error reading Roles: %s", err)
+	if !r.readRule(ctx, &state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+		return
 	}
-	if err := d.Set("compilation_error", rule.CompilationError); err != nil {
-		return fmt.Errorf("error reading Roles: %s", err)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// readRule reads the rule into model, returning false if it failed or the rule is gone.
+func (r *ruleResource) readRule(ctx context.Context, model *ruleResourceModel, diags *diag.Diagnostics) bool {
+	id := model.ID.ValueString()
+	rule, err := r.client.GetRule(id)
+	if err != nil {
+		if IsChronicleAPIErrorWithCode(err, 404) {
+			log.Printf("Removing %s because it's gone", id)
+			return false
+		}
+		diags.AddError("error reading Rule", err.Error())
+		return false
 	}
 
-	log.Printf("[DEBUG] Finished reading Rule %q: %#v", d.Id(), rule)
+	metadata, d := types.MapValueFrom(ctx, types.StringType, rule.Metadata)
+	diags.Append(d...)
+
+	model.RuleText = types.StringValue(rule.Text)
+	model.VersionID = types.StringValue(rule.VersionID)
+	model.RuleName = types.StringValue(rule.Name)
+	model.Metadata = metadata
+	model.RuleType = types.StringValue(rule.Type)
+	model.LiveEnabled = types.BoolValue(rule.LiveEnabled)
+	model.AlertingEnabled = types.BoolValue(rule.AlertingEnabled)
+	model.VersionCreateTime = types.StringValue(rule.VersionCreateTime)
+	model.CompilationState = types.StringValue(rule.CompilationState)
+	model.CompilationError = types.StringValue(rule.CompilationError)
 
-	return nil
+	log.Printf("[DEBUG] Finished reading Rule %q: %#v", id, rule)
+
+	return !diags.HasError()
 }
 
-func resourceRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*chronicle.Client)
+func (r *ruleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ruleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.ID.ValueString()
 
 	// An update to rule_text creates a new version of the rule
-	if d.HasChange("rule_text") {
-		ruleText := readStringFromResource(d, "rule_text")
+	if !plan.RuleText.Equal(state.RuleText) {
 		ruleVersion := chronicle.Rule{
-			ID:   d.Id(),
-			Text: ruleText,
+			ID:   id,
+			Text: plan.RuleText.ValueString(),
 		}
 
-		if ok, err := client.VerifyYARARule(ruleVersion.Text); !ok {
-			return fmt.Errorf("error verifying YARA-L 2.0 rule: %s", err)
+		if ok, err := r.client.VerifyYARARule(ruleVersion.Text); !ok {
+			resp.Diagnostics.AddError("error verifying YARA-L 2.0 rule", err.Error())
+			return
 		}
 
-		err := client.CreateRuleVersion(ruleVersion)
-		if err != nil {
-			return fmt.Errorf("error creating rule version for rule %q: %s", d.Id(), err)
-		} else {
-			log.Printf("[DEBUG] Finished creating new rule version for rule %q: %#v", d.Id(), ruleVersion)
+		if err := r.client.CreateRuleVersion(ruleVersion); err != nil {
+			resp.Diagnostics.AddError("error creating rule version for rule "+id, err.Error())
+			return
 		}
+		log.Printf("[DEBUG] Finished creating new rule version for rule %q: %#v", id, ruleVersion)
 	}
 
-	if d.HasChange("alerting_enabled") {
-		log.Printf("[DEBUG] Enabling alerting for rule : %#v", d.Id())
+	if !plan.AlertingEnabled.IsUnknown() && !plan.AlertingEnabled.Equal(state.AlertingEnabled) {
+		log.Printf("[DEBUG] Enabling alerting for rule : %#v", id)
 
-		err := client.ChangeAlertingRule(d.Id(), readBoolFromResource(d, "alerting_enabled"))
-		if err != nil {
-			return fmt.Errorf("error enabling alerting: %s", err)
-		} else {
-			log.Printf("[DEBUG] Finished updating alerting for rule : %#v", d.Id())
+		if err := r.client.ChangeAlertingRule(id, plan.AlertingEnabled.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("error enabling alerting", err.Error())
+			return
 		}
+		log.Printf("[DEBUG] Finished updating alerting for rule : %#v", id)
 	}
 
-	if d.HasChange("live_enabled") {
-		log.Printf("[DEBUG] Enabling live rule for rule : %#v", d.Id())
+	if !plan.LiveEnabled.IsUnknown() && !plan.LiveEnabled.Equal(state.LiveEnabled) {
+		log.Printf("[DEBUG] Enabling live rule for rule : %#v", id)
 
-		err := client.ChangeLiveRule(d.Id(), readBoolFromResource(d, "live_enabled"))
-		if err != nil {
-			return fmt.Errorf("error enabling live rule: %s", err)
-		} else {
-			log.Printf("[DEBUG] Finished enabling live rule for rule : %#v", d.Id())
+		if err := r.client.ChangeLiveRule(id, plan.LiveEnabled.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("error enabling live rule", err.Error())
+			return
 		}
+		log.Printf("[DEBUG] Finished enabling live rule for rule : %#v", id)
 	}
 
-	return resourceRuleRead(d, meta)
+	plan.ID = state.ID
+	if r.readRule(ctx, &plan, &resp.Diagnostics) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	}
 }
 
-func resourceRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*chronicle.Client)
+func (r *ruleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ruleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	log.Printf("[DEBUG] Deleting Schema: %#v", d.Id())
-	err := client.DeleteRule(d.Id())
-	if err != nil {
-		return handleNotFoundError(err, d, "Rule")
+	log.Printf("[DEBUG] Deleting Rule: %#v", state.ID.ValueString())
+	if err := r.client.DeleteRule(state.ID.ValueString()); err != nil && !isGoogleAPIErrorWithCode(err, 404) {
+		resp.Diagnostics.AddError("error deleting Rule", err.Error())
+		return
 	}
 
-	log.Printf("[DEBUG] Finished deleting Rule %q", d.Id())
+	log.Printf("[DEBUG] Finished deleting Rule %q", state.ID.ValueString())
+}
 
-	return nil
+func (r *ruleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
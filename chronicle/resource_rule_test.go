@@ -1,13 +1,74 @@
 package chronicle
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestRuleResource_UpgradeSDKState(t *testing.T) {
+	attributes := testUpgradeSDKState(t, "chronicle_rule", `{
+		"id": "ru_1234",
+		"rule_text": "rule test { condition: true }\n",
+		"rule_name": "test",
+		"version_id": "ru_1234@v_1_1",
+		"metadata": {"author": "test"},
+		"rule_type": "SINGLE_EVENT",
+		"live_enabled": true,
+		"alerting_enabled": false,
+		"version_create_time": "2023-01-01T00:00:00Z",
+		"compilation_state": "SUCCEEDED",
+		"compilation_error": "",
+		"timeouts": null
+	}`)
+
+	expected := map[string]tftypes.Value{
+		"id":               tftypes.NewValue(tftypes.String, "ru_1234"),
+		"version_id":       tftypes.NewValue(tftypes.String, "ru_1234@v_1_1"),
+		"metadata":         tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{"author": tftypes.NewValue(tftypes.String, "test")}),
+		"live_enabled":     tftypes.NewValue(tftypes.Bool, true),
+		"alerting_enabled": tftypes.NewValue(tftypes.Bool, false),
+	}
+	for name, value := range expected {
+		if !attributes[name].Equal(value) {
+			t.Errorf("%s: expected %s, got %s", name, value, attributes[name])
+		}
+	}
+}
+
+func TestVerifyYARARule_CustomRuleEndpoint(t *testing.T) {
+	var verifiedPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		verifiedPath = r.URL.Path
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+	}))
+	defer server.Close()
+
+	client, err := chronicle.NewClient(chronicle.RegionEurope, "test", context.Background(),
+		chronicle.WithBackstoryAPIAccessToken("token"),
+		chronicle.WithInstance(chronicle.APIVersionV1Alpha, "project", "eu", "00000000-0000-0000-0000-000000000000"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	client.WithRuleBasePath(server.URL + "/custom/rules/v2")
+	client.WithInstanceBasePath(server.URL + "/v1alpha/projects/project/locations/eu/instances/00000000-0000-0000-0000-000000000000")
+
+	if _, err := client.VerifyYARARule("rule test { condition: true }"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if expected := "/v1alpha/projects/project/locations/eu/instances/00000000-0000-0000-0000-000000000000:verifyRuleText"; verifiedPath != expected {
+		t.Errorf("expected rule text to be verified at %s, got %s", expected, verifiedPath)
+	}
+}
+
 func TestAccChronicleRule_Basic(t *testing.T) {
 	ruleText := `rule singleEventRule2{meta:      author = "securityuser"      description = "single event rule that should generate detections TEST"
 	    events:      $e.metadata.event_type = "NETWORK_DNS"    condition:       $e}` + "\n"
@@ -17,9 +78,9 @@ func TestAccChronicleRule_Basic(t *testing.T) {
 	rootRef := rulePolicyRef("test")
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckChronicleRuleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChronicleRuleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckChronicleRule(ruleText, liveDisabled, alertingDisabled),
@@ -48,9 +109,9 @@ func TestAccChronicleRule_BasicTrailingNewLineRuleText(t *testing.T) {
 	rootRef := rulePolicyRef("test")
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckChronicleRuleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChronicleRuleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckChronicleRule(ruleText, liveDisabled, alertingDisabled),
@@ -81,9 +142,9 @@ func TestAccChronicleRule_UpdateRuleText(t *testing.T) {
 	rootRef := rulePolicyRef("test")
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckChronicleRuleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChronicleRuleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckChronicleRule(ruleText, liveDisabled, alertingDisabled),
@@ -122,9 +183,9 @@ func TestAccChronicleRule_UpdateAlerting(t *testing.T) {
 	rootRef := rulePolicyRef("test")
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckChronicleRuleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChronicleRuleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckChronicleRule(ruleText, liveDisabled, alertingDisabled),
@@ -163,9 +224,9 @@ func TestAccChronicleRule_UpdateLive(t *testing.T) {
 	rootRef := rulePolicyRef("test")
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckChronicleRuleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChronicleRuleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckChronicleRule(ruleText, liveDisabled, alertingDisabled),
@@ -203,9 +264,9 @@ func TestAccChronicleRule_LiveAndAlertingEnabled(t *testing.T) {
 	rootRef := rulePolicyRef("test")
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckChronicleRuleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChronicleRuleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckChronicleRule(ruleText, liveEnabled, alertingEnabled),
@@ -238,9 +299,9 @@ func TestAccChronicleRule_UpdateRuleTextAndLiveAndAlerting(t *testing.T) {
 	rootRef := rulePolicyRef("test")
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckChronicleRuleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChronicleRuleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckChronicleRule(ruleText, liveDisabled, alertingDisabled),
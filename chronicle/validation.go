@@ -2,11 +2,13 @@ package chronicle
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	chronicle "github.com/form3tech-oss/terraform-provider-chronicle/client"
 	"github.com/google/uuid"
@@ -39,8 +41,29 @@ func validateRegion(v interface{}, k cty.Path) diag.Diagnostics {
 	return nil
 }
 
+func validateAPIVersion(v interface{}, k cty.Path) diag.Diagnostics {
+	apiVersion := v.(string)
+
+	if !contains(chronicle.APIVersions, apiVersion) {
+		return diag.FromErr(fmt.Errorf("api version %s not valid, valid versions are: %s", apiVersion, chronicle.APIVersions))
+	}
+
+	return nil
+}
+
 func isValidRegion(region string) bool {
-	return contains(chronicle.Regions, region)
+	return chronicle.IsValidRegion(region)
+}
+
+func validateBaseURLTemplate(v interface{}, k cty.Path) diag.Diagnostics {
+	template := v.(string)
+
+	baseURL := chronicle.ResolveBaseURL(template, chronicle.FeedManagementAPIKey, chronicle.RegionEurope)
+	if _, err := url.ParseRequestURI(baseURL); err != nil {
+		return diag.FromErr(fmt.Errorf("base URL template %q does not resolve to a valid URL: %s", template, err))
+	}
+
+	return nil
 }
 
 func validateFeedS3SourceDeleteOption(v interface{}, k cty.Path) diag.Diagnostics {
@@ -201,3 +224,327 @@ func validateReferenceListContentType(v interface{}, k cty.Path) diag.Diagnostic
 	}
 	return nil
 }
+
+func validateForwarderRegexFilterBehavior(v interface{}, k cty.Path) diag.Diagnostics {
+	behaviors := []string{ForwarderRegexFilterBehaviorAllow, ForwarderRegexFilterBehaviorBlock}
+	behavior := v.(string)
+	if !contains(behaviors, behavior) {
+		return diag.FromErr(fmt.Errorf("regex filter behavior %s not valid, valid behaviors are: %s", behavior, behaviors))
+	}
+	return nil
+}
+
+func validateForwarderState(v interface{}, k cty.Path) diag.Diagnostics {
+	states := []string{ForwarderStateActive, ForwarderStateSuspended}
+	state := v.(string)
+	if !contains(states, state) {
+		return diag.FromErr(fmt.Errorf("state %s not valid, valid states are: %s", state, states))
+	}
+	return nil
+}
+
+func validateForwarderCollectorSyslogProtocol(v interface{}, k cty.Path) diag.Diagnostics {
+	protocols := []string{ForwarderCollectorSyslogProtocolTCP, ForwarderCollectorSyslogProtocolUDP}
+	protocol := v.(string)
+	if !contains(protocols, protocol) {
+		return diag.FromErr(fmt.Errorf("syslog protocol %s not valid, valid protocols are: %s", protocol, protocols))
+	}
+	return nil
+}
+
+func validateFeedDetailsJSON(v interface{}, k cty.Path) diag.Diagnostics {
+	if _, _, err := parseFeedDetailsJSON(v.(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func validateHTTPURI(v interface{}, k cty.Path) diag.Diagnostics {
+	reg := `^https?:\/\/.+$`
+	return validateRegexp(reg)(v, k)
+}
+
+func validateFeedHTTPSourceType(v interface{}, k cty.Path) diag.Diagnostics {
+	sourceTypes := []string{FeedHTTPSourceTypeFiles}
+	sourceType := v.(string)
+	if !contains(sourceTypes, sourceType) {
+		return diag.FromErr(fmt.Errorf("source type %s not valid, valid types are: %s", sourceType, sourceTypes))
+	}
+
+	return nil
+}
+
+func validateFeedHTTPSourceDeleteOption(v interface{}, k cty.Path) diag.Diagnostics {
+	deletionOptions := []string{FeedHTTPSourceDeleteOptionDeletionNever, FeedHTTPSourceDeleteOptionDeletionOnSuccess, FeedHTTPSourceDeleteOptionDeletionOnSuccessFilesOnly}
+	option := v.(string)
+	if !contains(deletionOptions, option) {
+		return diag.FromErr(fmt.Errorf("source deletion option %s not valid, valid options are: %s", option, deletionOptions))
+	}
+
+	return nil
+}
+
+// parseAzureConnectionString splits an Azure connection string, e.g. Endpoint=sb://ns.servicebus.windows.net/;SharedAccessKeyName=name;SharedAccessKey=key,
+// into its settings. Setting names are lowercased as Azure treats them case-insensitively.
+func parseAzureConnectionString(connectionString string) (map[string]string, error) {
+	settings := map[string]string{}
+	for _, part := range strings.Split(connectionString, ";") {
+		if part == "" {
+			continue
+		}
+		key, value, found := strings.Cut(part, "=")
+		if !found || key == "" || value == "" {
+			return nil, fmt.Errorf("%q is not a key=value pair", part)
+		}
+		settings[strings.ToLower(key)] = value
+	}
+
+	return settings, nil
+}
+
+func validateAzureEventHubConnectionString(v interface{}, k cty.Path) diag.Diagnostics {
+	settings, err := parseAzureConnectionString(v.(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("event hub connection string not valid: %s", err))
+	}
+
+	if !strings.HasPrefix(settings["endpoint"], "sb://") {
+		return diag.FromErr(fmt.Errorf("event hub connection string not valid: Endpoint must be set to sb://<namespace>.servicebus.windows.net/"))
+	}
+	_, hasSignature := settings["sharedaccesssignature"]
+	_, hasKeyName := settings["sharedaccesskeyname"]
+	_, hasKey := settings["sharedaccesskey"]
+	if !hasSignature && !(hasKeyName && hasKey) {
+		return diag.FromErr(fmt.Errorf("event hub connection string not valid: either SharedAccessKeyName and SharedAccessKey or SharedAccessSignature must be set"))
+	}
+
+	return nil
+}
+
+func validateAzureStorageConnectionString(v interface{}, k cty.Path) diag.Diagnostics {
+	settings, err := parseAzureConnectionString(v.(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("storage connection string not valid: %s", err))
+	}
+
+	_, hasSignature := settings["sharedaccesssignature"]
+	_, hasAccountName := settings["accountname"]
+	_, hasAccountKey := settings["accountkey"]
+	if !hasSignature && !(hasAccountName && hasAccountKey) {
+		return diag.FromErr(fmt.Errorf("storage connection string not valid: either AccountName and AccountKey or SharedAccessSignature must be set"))
+	}
+
+	return nil
+}
+
+func validateFeedGCSV2MaxFileAgeDays(v interface{}, k cty.Path) diag.Diagnostics {
+	days := v.(int)
+	if days < 1 {
+		return diag.FromErr(fmt.Errorf("max file age %d not valid, it must be at least 1 day", days))
+	}
+
+	return nil
+}
+
+func validateAWSIAMRoleARN(v interface{}, k cty.Path) diag.Diagnostics {
+	reg := `^arn:aws[a-z-]*:iam::\d{12}:role\/.+$`
+	return validateRegexp(reg)(v, k)
+}
+
+func validateFeedScheduleInterval(v interface{}, k cty.Path) diag.Diagnostics {
+	interval, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("interval %q not valid, it must be a duration such as 30m or 6h: %s", v, err))
+	}
+	if interval < time.Minute || interval%time.Second != 0 {
+		return diag.FromErr(fmt.Errorf("interval %q not valid, it must be a whole number of seconds of at least a minute", v))
+	}
+
+	return nil
+}
+
+func validateFeedScheduleCron(v interface{}, k cty.Path) diag.Diagnostics {
+	reg := `^\S+(\s+\S+){4}$`
+	return validateRegexp(reg)(v, k)
+}
+
+func validateRFC3339Time(v interface{}, k cty.Path) diag.Diagnostics {
+	if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+		return diag.FromErr(fmt.Errorf("time %q not valid, it must follow RFC 3339, e.g. 2024-01-01T00:00:00Z: %s", v, err))
+	}
+
+	return nil
+}
+
+// googleServiceAccountKey holds the fields of a Google Cloud service account key file used to sign tokens.
+type googleServiceAccountKey struct {
+	Type        string `json:"type"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+func parseGoogleServiceAccountKey(key string) (*googleServiceAccountKey, error) {
+	var serviceAccountKey googleServiceAccountKey
+	if err := json.Unmarshal([]byte(key), &serviceAccountKey); err != nil {
+		return nil, err
+	}
+	if serviceAccountKey.Type != "service_account" || serviceAccountKey.ClientEmail == "" || serviceAccountKey.PrivateKey == "" {
+		return nil, fmt.Errorf("type must be service_account, with client_email and private_key set")
+	}
+
+	return &serviceAccountKey, nil
+}
+
+func validateGoogleServiceAccountKey(v interface{}, k cty.Path) diag.Diagnostics {
+	if _, err := parseGoogleServiceAccountKey(v.(string)); err != nil {
+		return diag.FromErr(fmt.Errorf("service account key not valid: %s", err))
+	}
+
+	return nil
+}
+
+func validateGoogleWorkspaceCustomerID(v interface{}, k cty.Path) diag.Diagnostics {
+	reg := `^C[0-9a-zA-Z]+$`
+	return validateRegexp(reg)(v, k)
+}
+
+func validateEmail(v interface{}, k cty.Path) diag.Diagnostics {
+	reg := `^[^@\s]+@[^@\s]+\.[^@\s]+$`
+	return validateRegexp(reg)(v, k)
+}
+
+func validateFeedGoogleWorkspaceActivityApplication(v interface{}, k cty.Path) diag.Diagnostics {
+	application := v.(string)
+	if !contains(feedGoogleWorkspaceActivityApplications, application) {
+		return diag.FromErr(fmt.Errorf("application %s not valid, valid applications are: %s", application, feedGoogleWorkspaceActivityApplications))
+	}
+
+	return nil
+}
+
+func validateMicrosoftGraphHostname(v interface{}, k cty.Path) diag.Diagnostics {
+	reg := `^(graph\.microsoft\.com|graph\.microsoft\.us|dod-graph\.microsoft\.us|microsoftgraph\.chinacloudapi\.cn)(/.*)?$`
+	return validateRegexp(reg)(v, k)
+}
+
+func validateMicrosoftLoginHostname(v interface{}, k cty.Path) diag.Diagnostics {
+	reg := `^(login\.microsoftonline\.com|login\.microsoftonline\.us|login\.chinacloudapi\.cn)$`
+	return validateRegexp(reg)(v, k)
+}
+
+func validateCrowdStrikeFalconHostname(v interface{}, k cty.Path) diag.Diagnostics {
+	reg := `^api\.(us-2\.|eu-1\.|laggar\.gcw\.)?crowdstrike\.com$`
+	return validateRegexp(reg)(v, k)
+}
+
+func validateSentinelOneHostname(v interface{}, k cty.Path) diag.Diagnostics {
+	reg := `^[a-zA-Z0-9-]+\.sentinelone\.net$`
+	return validateRegexp(reg)(v, k)
+}
+
+func validateMicrosoftDefenderHostname(v interface{}, k cty.Path) diag.Diagnostics {
+	reg := `^api(-[a-z0-9]+)?\.(securitycenter\.microsoft\.com|securitycenter\.microsoft\.us|security\.microsoft\.com)$`
+	return validateRegexp(reg)(v, k)
+}
+
+func validateSalesforceHostname(v interface{}, k cty.Path) diag.Diagnostics {
+	reg := `^[a-zA-Z0-9-]+\.my\.salesforce\.com$`
+	return validateRegexp(reg)(v, k)
+}
+
+func validateDuoAdminHostname(v interface{}, k cty.Path) diag.Diagnostics {
+	reg := `^api-[a-z0-9]+\.duosecurity\.com$`
+	return validateRegexp(reg)(v, k)
+}
+
+func validateWorkdayHostname(v interface{}, k cty.Path) diag.Diagnostics {
+	reg := `^[a-zA-Z0-9.-]+\.(my)?workday\.com$`
+	return validateRegexp(reg)(v, k)
+}
+
+// boxAppConfig holds the fields of the JSON configuration of a Box application using JWT authentication.
+type boxAppConfig struct {
+	BoxAppSettings struct {
+		ClientID     string `json:"clientID"`
+		ClientSecret string `json:"clientSecret"`
+		AppAuth      struct {
+			PublicKeyID string `json:"publicKeyID"`
+			PrivateKey  string `json:"privateKey"`
+			Passphrase  string `json:"passphrase"`
+		} `json:"appAuth"`
+	} `json:"boxAppSettings"`
+	EnterpriseID string `json:"enterpriseID"`
+}
+
+func parseBoxAppConfig(config string) (*boxAppConfig, error) {
+	var appConfig boxAppConfig
+	if err := json.Unmarshal([]byte(config), &appConfig); err != nil {
+		return nil, err
+	}
+	settings := appConfig.BoxAppSettings
+	if settings.ClientID == "" || settings.ClientSecret == "" || settings.AppAuth.PublicKeyID == "" || settings.AppAuth.PrivateKey == "" {
+		return nil, fmt.Errorf("boxAppSettings must set clientID, clientSecret, appAuth.publicKeyID and appAuth.privateKey")
+	}
+
+	return &appConfig, nil
+}
+
+func validateBoxAppConfig(v interface{}, k cty.Path) diag.Diagnostics {
+	if _, err := parseBoxAppConfig(v.(string)); err != nil {
+		return diag.FromErr(fmt.Errorf("box app config not valid: %s", err))
+	}
+
+	return nil
+}
+
+func validateCloudflareAccountID(v interface{}, k cty.Path) diag.Diagnostics {
+	reg := `^[0-9a-f]{32}$`
+	return validateRegexp(reg)(v, k)
+}
+
+func validateFeedZscalerCloudName(v interface{}, k cty.Path) diag.Diagnostics {
+	cloudName := v.(string)
+	if !contains(feedZscalerCloudNames, cloudName) {
+		return diag.FromErr(fmt.Errorf("cloud name %s not valid, valid cloud names are: %s", cloudName, feedZscalerCloudNames))
+	}
+	return nil
+}
+
+func validateNetskopeHostname(v interface{}, k cty.Path) diag.Diagnostics {
+	reg := `^[a-zA-Z0-9-]+(\.[a-z]{2})?\.goskope\.com$`
+	return validateRegexp(reg)(v, k)
+}
+
+func validateFeedNetskopeContentType(v interface{}, k cty.Path) diag.Diagnostics {
+	contentTypes := []string{FeedNetskopeContentTypeAlerts}
+	contentType := v.(string)
+	if !contains(contentTypes, contentType) {
+		return diag.FromErr(fmt.Errorf("content type %s not valid, valid types are: %s", contentType, contentTypes))
+	}
+	return nil
+}
+
+func validateFeedNetskopeContentCategory(v interface{}, k cty.Path) diag.Diagnostics {
+	category := v.(string)
+	if !contains(feedNetskopeAlertTypes, category) {
+		return diag.FromErr(fmt.Errorf("content category %s not valid, valid alert types are: %s", category, feedNetskopeAlertTypes))
+	}
+	return nil
+}
+
+func validateFeedCortexDataLakeRegion(v interface{}, k cty.Path) diag.Diagnostics {
+	region := v.(string)
+	if !contains(feedCortexDataLakeRegions, region) {
+		return diag.FromErr(fmt.Errorf("region %s not valid, valid regions are: %s", region, feedCortexDataLakeRegions))
+	}
+	return nil
+}
+
+func validateFeedMimecastBaseURL(v interface{}, k cty.Path) diag.Diagnostics {
+	baseURL := v.(string)
+	if !contains(feedMimecastBaseURLs, baseURL) {
+		return diag.FromErr(fmt.Errorf("base URL %s not valid, valid base URLs are: %s", baseURL, feedMimecastBaseURLs))
+	}
+	return nil
+}
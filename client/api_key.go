@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// apiKeyOperationPollInterval is how often operations of the API Keys API are polled until done.
+const apiKeyOperationPollInterval = 2 * time.Second
+
+type apiKey struct {
+	Name         string             `json:"name,omitempty"`
+	DisplayName  string             `json:"displayName,omitempty"`
+	Restrictions *apiKeyRestriction `json:"restrictions,omitempty"`
+}
+
+type apiKeyRestriction struct {
+	APITargets []apiKeyTarget `json:"apiTargets"`
+}
+
+type apiKeyTarget struct {
+	Service string `json:"service"`
+}
+
+type apiKeyOperation struct {
+	Name     string          `json:"name"`
+	Done     bool            `json:"done"`
+	Error    json.RawMessage `json:"error,omitempty"`
+	Response apiKey          `json:"response"`
+}
+
+// CreateChronicleAPIKey creates a Google Cloud API key of the instance project, restricted to the Chronicle API, which
+// push feeds authenticate with on top of their secret. It returns the name of the key and the key itself.
+func (cli *Client) CreateChronicleAPIKey(displayName string) (string, string, error) {
+	if err := cli.CheckPushFeedsSupported(); err != nil {
+		return "", "", err
+	}
+
+	err := cli.rateLimiters.APIKeysCreateKey.Wait(context.Background())
+	if err != nil {
+		return "", "", errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while creating API key %s", displayName))
+	}
+
+	url := fmt.Sprintf("%s/projects/%s/locations/global/keys", cli.APIKeysBasePath, cli.project)
+	key := apiKey{
+		DisplayName:  displayName,
+		Restrictions: &apiKeyRestriction{APITargets: []apiKeyTarget{{Service: ChronicleAPIService}}},
+	}
+
+	res, err := sendRequest(cli, cli.backstoryAPIClient, "POST", cli.userAgent, url, key)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed creating API key")
+	}
+
+	var operation apiKeyOperation
+	if err := json.Unmarshal(res, &operation); err != nil {
+		return "", "", errors.Wrap(err, "failed decoding API key operation")
+	}
+
+	deadline := time.Now().Add(cli.requestTimeout)
+	for !operation.Done {
+		if time.Now().After(deadline) {
+			return "", "", fmt.Errorf("timed out waiting for API key operation %s", operation.Name)
+		}
+		time.Sleep(apiKeyOperationPollInterval)
+
+		res, err := sendRequest(cli, cli.backstoryAPIClient, "GET", cli.userAgent, fmt.Sprintf("%s/%s", cli.APIKeysBasePath, operation.Name), nil)
+		if err != nil {
+			return "", "", errors.Wrap(err, "failed getting API key operation")
+		}
+		if err := json.Unmarshal(res, &operation); err != nil {
+			return "", "", errors.Wrap(err, "failed decoding API key operation")
+		}
+	}
+	if len(operation.Error) > 0 {
+		return "", "", fmt.Errorf("failed creating API key: %s", operation.Error)
+	}
+
+	keyString, err := cli.getAPIKeyString(operation.Response.Name)
+	if err != nil {
+		return "", "", err
+	}
+
+	return operation.Response.Name, keyString, nil
+}
+
+func (cli *Client) getAPIKeyString(name string) (string, error) {
+	res, err := sendRequest(cli, cli.backstoryAPIClient, "GET", cli.userAgent, fmt.Sprintf("%s/%s/keyString", cli.APIKeysBasePath, name), nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed getting API key")
+	}
+
+	var result struct {
+		KeyString string `json:"keyString"`
+	}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return "", errors.Wrap(err, "failed decoding API key")
+	}
+
+	return result.KeyString, nil
+}
+
+// DeleteAPIKey deletes the API key name, as returned by CreateChronicleAPIKey.
+func (cli *Client) DeleteAPIKey(name string) error {
+	err := cli.rateLimiters.APIKeysDeleteKey.Wait(context.Background())
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while deleting API key %s", name))
+	}
+
+	// The operation is not waited for, the key is unusable as soon as it is deleted.
+	_, err = sendRequest(cli, cli.backstoryAPIClient, "DELETE", cli.userAgent, fmt.Sprintf("%s/%s", cli.APIKeysBasePath, name), nil)
+	if err != nil {
+		return errors.Wrap(err, "failed deleting API key")
+	}
+
+	return nil
+}
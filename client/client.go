@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"golang.org/x/oauth2"
 	googleoauth "golang.org/x/oauth2/google"
+	"google.golang.org/api/impersonate"
 	"google.golang.org/api/option"
 	"google.golang.org/api/transport"
 )
@@ -28,6 +31,31 @@ type Client struct {
 	ingestionAPIClient *http.Client
 	forwarderAPIClient *http.Client
 
+	bigQueryAPICredentials  *apiCredentials
+	backstoryAPICredentials *apiCredentials
+	ingestionAPICredentials *apiCredentials
+	forwarderAPICredentials *apiCredentials
+
+	impersonateServiceAccount          string
+	impersonateServiceAccountDelegates []string
+
+	region          string
+	baseURLTemplate string
+
+	apiVersion   string
+	project      string
+	location     string
+	instanceID   string
+	instanceName string
+
+	customerID string
+
+	validateLogTypes bool
+	logTypesMutex    sync.Mutex
+	logTypes         []LogType
+
+	iamPolicyMutex sync.Mutex
+
 	EventsBasePath         string
 	AlertBasePath          string
 	ArtifactBasePath       string
@@ -38,10 +66,27 @@ type Client struct {
 	FeedManagementBasePath string
 	SubjectsBasePath       string
 	ReferenceListsBasePath string
+	ForwarderBasePath      string
+	IngestionBasePath      string
+	LogTypesBasePath       string
+	APIKeysBasePath        string
+	// InstanceBasePath is the instance itself, for the calls which are not scoped to any of its collections.
+	InstanceBasePath string
 }
 
 type Option func(*Client) error
 
+// apiCredentials are the credentials configured for an API. They are loaded once every option is applied, so that
+// the impersonation and instance options apply whatever their order.
+type apiCredentials struct {
+	scopes      []string
+	accessToken string
+	credentials string
+	envVariable string
+	// defaultCredentials are Application Default Credentials, which may not be available.
+	defaultCredentials bool
+}
+
 const (
 	defaultRequestAttempts = 5
 	defaultRequestTimeout  = time.Second * 120
@@ -52,26 +97,31 @@ var defaultClientScopes = []string{
 	"https://www.googleapis.com/auth/malachite-ingestion",
 }
 
-func NewClient(region string, userAgent string, ctx context.Context, opts ...Option) (*Client, error) {
-	defaultBasePaths := GenerateDefaultBasePaths(region)
+// instanceAPIScopes are required by the instance-scoped Chronicle API on top of defaultClientScopes.
+var instanceAPIScopes = []string{
+	"https://www.googleapis.com/auth/cloud-platform",
+}
+
+// impersonationSourceScopes are requested on the source credentials when impersonating,
+// as the IAM Credentials API does not accept the Chronicle scopes.
+var impersonationSourceScopes = []string{
+	"https://www.googleapis.com/auth/cloud-platform",
+}
 
+func NewClient(region string, userAgent string, ctx context.Context, opts ...Option) (*Client, error) {
 	client := &Client{
 		userAgent:       userAgent,
+		region:          region,
+		baseURLTemplate: DefaultBaseURLTemplate,
+		apiVersion:      APIVersionLegacy,
 		requestAttempts: defaultRequestAttempts,
 		requestTimeout:  defaultRequestTimeout,
 		context:         ctx,
 		rateLimiters:    *NewClientRateLimiters(),
+	}
 
-		EventsBasePath:         defaultBasePaths[EventsBasePathKey],
-		AlertBasePath:          defaultBasePaths[AlertBasePathKey],
-		ArtifactBasePath:       defaultBasePaths[ArtifactBasePathKey],
-		AliasBasePath:          defaultBasePaths[AliasBasePathKey],
-		AssetBasePath:          defaultBasePaths[AssetBasePathKey],
-		IOCBasePath:            defaultBasePaths[IOCBasePathKey],
-		RuleBasePath:           defaultBasePaths[RuleBasePathKey],
-		FeedManagementBasePath: defaultBasePaths[FeedManagementBasePathKey],
-		SubjectsBasePath:       defaultBasePaths[SubjectsBasePathKey],
-		ReferenceListsBasePath: defaultBasePaths[ReferenceListsPathKey],
+	if !IsValidRegion(region) {
+		return nil, fmt.Errorf("region %s is not valid, valid regions are: %s", region, Regions)
 	}
 
 	for _, opt := range opts {
@@ -81,9 +131,46 @@ func NewClient(region string, userAgent string, ctx context.Context, opts ...Opt
 		}
 	}
 
+	if err := client.initAPIClients(); err != nil {
+		return nil, err
+	}
+
+	client.setDefaultBasePaths()
+
 	return client, nil
 }
 
+func (cli *Client) setDefaultBasePaths() {
+	defaultBasePaths := GenerateBasePaths(cli.baseURLTemplate, cli.region)
+
+	cli.EventsBasePath = defaultBasePaths[EventsBasePathKey]
+	cli.AlertBasePath = defaultBasePaths[AlertBasePathKey]
+	cli.ArtifactBasePath = defaultBasePaths[ArtifactBasePathKey]
+	cli.AliasBasePath = defaultBasePaths[AliasBasePathKey]
+	cli.AssetBasePath = defaultBasePaths[AssetBasePathKey]
+	cli.IOCBasePath = defaultBasePaths[IOCBasePathKey]
+	cli.RuleBasePath = defaultBasePaths[RuleBasePathKey]
+	cli.FeedManagementBasePath = defaultBasePaths[FeedManagementBasePathKey]
+	cli.SubjectsBasePath = defaultBasePaths[SubjectsBasePathKey]
+	cli.ReferenceListsBasePath = defaultBasePaths[ReferenceListsPathKey]
+	cli.ForwarderBasePath = defaultBasePaths[ForwarderBasePathKey]
+	cli.IngestionBasePath = defaultBasePaths[IngestionBasePathKey]
+	cli.LogTypesBasePath = defaultBasePaths[LogTypesBasePathKey]
+	cli.APIKeysBasePath = defaultBasePaths[APIKeysBasePathKey]
+
+	if cli.usesInstanceAPI() {
+		instanceBasePaths := GenerateInstanceBasePaths(cli.baseURLTemplate, cli.apiVersion, cli.project, cli.location, cli.instanceID)
+
+		cli.RuleBasePath = instanceBasePaths[RuleBasePathKey]
+		cli.FeedManagementBasePath = instanceBasePaths[FeedManagementBasePathKey]
+		cli.ReferenceListsBasePath = instanceBasePaths[ReferenceListsPathKey]
+		cli.ForwarderBasePath = instanceBasePaths[ForwarderBasePathKey]
+		cli.LogTypesBasePath = instanceBasePaths[LogTypesBasePathKey]
+		cli.SubjectsBasePath = instanceBasePaths[SubjectsBasePathKey]
+		cli.InstanceBasePath = instanceBasePaths[InstanceBasePathKey]
+	}
+}
+
 func (cli *Client) WithEventsBasePath(uri string) *Client {
 	cli.EventsBasePath = uri
 	return cli
@@ -129,99 +216,213 @@ func (cli *Client) WithSubjectsBasePath(uri string) *Client {
 	return cli
 }
 
+func (cli *Client) WithReferenceListsBasePath(uri string) *Client {
+	cli.ReferenceListsBasePath = uri
+	return cli
+}
+
+func (cli *Client) WithForwarderBasePath(uri string) *Client {
+	cli.ForwarderBasePath = uri
+	return cli
+}
+
+func (cli *Client) WithIngestionBasePath(uri string) *Client {
+	cli.IngestionBasePath = uri
+	return cli
+}
+
+func (cli *Client) WithLogTypesBasePath(uri string) *Client {
+	cli.LogTypesBasePath = uri
+	return cli
+}
+
+func (cli *Client) WithAPIKeysBasePath(uri string) *Client {
+	cli.APIKeysBasePath = uri
+	return cli
+}
+
+func (cli *Client) WithInstanceBasePath(uri string) *Client {
+	cli.InstanceBasePath = uri
+	return cli
+}
+
 func WithBigQueryAPICredentials(credentials string) Option {
 	return func(cli *Client) error {
-		var err error
-		cli.bigQueryAPIClient, err = cli.initHTTPClient(defaultClientScopes, "", credentials, "")
-		return err
+		cli.bigQueryAPICredentials = &apiCredentials{scopes: defaultClientScopes, credentials: credentials}
+		return nil
 	}
 }
 
 func WithBigQueryAPIAccessToken(accesstoken string) Option {
 	return func(cli *Client) error {
-		var err error
-		cli.bigQueryAPIClient, err = cli.initHTTPClient(defaultClientScopes, accesstoken, "", "")
-		return err
+		cli.bigQueryAPICredentials = &apiCredentials{scopes: defaultClientScopes, accessToken: accesstoken}
+		return nil
 	}
 }
 
 func WithBigQueryAPIEnvVar() Option {
 	return func(cli *Client) error {
-		var err error
-		cli.bigQueryAPIClient, err = cli.initHTTPClient(defaultClientScopes, "", "", BigQueryAPIEnvVar)
-		return err
+		cli.bigQueryAPICredentials = &apiCredentials{scopes: defaultClientScopes, envVariable: BigQueryAPIEnvVar}
+		return nil
+	}
+}
+
+func WithBigQueryAPIDefaultCredentials() Option {
+	return func(cli *Client) error {
+		cli.bigQueryAPICredentials = &apiCredentials{scopes: defaultClientScopes, defaultCredentials: true}
+		return nil
 	}
 }
 
 func WithBackstoryAPICredentials(credentials string) Option {
 	return func(cli *Client) error {
-		var err error
-		cli.backstoryAPIClient, err = cli.initHTTPClient(defaultClientScopes, "", credentials, "")
-		return err
+		cli.backstoryAPICredentials = &apiCredentials{scopes: defaultClientScopes, credentials: credentials}
+		return nil
 	}
 }
 
 func WithBackstoryAPIAccessToken(accesstoken string) Option {
 	return func(cli *Client) error {
-		var err error
-		cli.backstoryAPIClient, err = cli.initHTTPClient(defaultClientScopes, accesstoken, "", "")
-		return err
+		cli.backstoryAPICredentials = &apiCredentials{scopes: defaultClientScopes, accessToken: accesstoken}
+		return nil
 	}
 }
 
 func WithBackstoryAPIEnvVar() Option {
 	return func(cli *Client) error {
-		var err error
-		cli.backstoryAPIClient, err = cli.initHTTPClient(defaultClientScopes, "", "", BackstoryAPIEnvVar)
-		return err
+		cli.backstoryAPICredentials = &apiCredentials{scopes: defaultClientScopes, envVariable: BackstoryAPIEnvVar}
+		return nil
+	}
+}
+
+func WithBackstoryAPIDefaultCredentials() Option {
+	return func(cli *Client) error {
+		cli.backstoryAPICredentials = &apiCredentials{scopes: defaultClientScopes, defaultCredentials: true}
+		return nil
 	}
 }
 
 func WithIngestionAPICredentials(credentials string) Option {
 	return func(cli *Client) error {
-		var err error
-		cli.ingestionAPIClient, err = cli.initHTTPClient(defaultClientScopes, "", credentials, "")
-		return err
+		cli.ingestionAPICredentials = &apiCredentials{scopes: defaultClientScopes, credentials: credentials}
+		return nil
 	}
 }
 
 func WithIngestionAPIAccessToken(accesstoken string) Option {
 	return func(cli *Client) error {
-		var err error
-		cli.ingestionAPIClient, err = cli.initHTTPClient(defaultClientScopes, accesstoken, "", "")
-		return err
+		cli.ingestionAPICredentials = &apiCredentials{scopes: defaultClientScopes, accessToken: accesstoken}
+		return nil
 	}
 }
 
 func WithIngestionAPIEnvVar() Option {
 	return func(cli *Client) error {
-		var err error
-		cli.ingestionAPIClient, err = cli.initHTTPClient(defaultClientScopes, "", "", IngestionAPIEnvVar)
-		return err
+		cli.ingestionAPICredentials = &apiCredentials{scopes: defaultClientScopes, envVariable: IngestionAPIEnvVar}
+		return nil
+	}
+}
+
+func WithIngestionAPIDefaultCredentials() Option {
+	return func(cli *Client) error {
+		cli.ingestionAPICredentials = &apiCredentials{scopes: defaultClientScopes, defaultCredentials: true}
+		return nil
 	}
 }
 
 func WithForwarderAPICredentials(credentials string) Option {
 	return func(cli *Client) error {
-		var err error
-		cli.forwarderAPIClient, err = cli.initHTTPClient(defaultClientScopes, "", credentials, "")
-		return err
+		cli.forwarderAPICredentials = &apiCredentials{scopes: defaultClientScopes, credentials: credentials}
+		return nil
 	}
 }
 
 func WithForwarderAPIAccessToken(accesstoken string) Option {
 	return func(cli *Client) error {
-		var err error
-		cli.forwarderAPIClient, err = cli.initHTTPClient(defaultClientScopes, accesstoken, "", "")
-		return err
+		cli.forwarderAPICredentials = &apiCredentials{scopes: defaultClientScopes, accessToken: accesstoken}
+		return nil
 	}
 }
 
 func WithForwarderAPIEnvVar() Option {
 	return func(cli *Client) error {
-		var err error
-		cli.forwarderAPIClient, err = cli.initHTTPClient(nil, "", "", ForwarderAPIEnvVar)
-		return err
+		cli.forwarderAPICredentials = &apiCredentials{scopes: nil, envVariable: ForwarderAPIEnvVar}
+		return nil
+	}
+}
+
+func WithForwarderAPIDefaultCredentials() Option {
+	return func(cli *Client) error {
+		cli.forwarderAPICredentials = &apiCredentials{scopes: defaultClientScopes, defaultCredentials: true}
+		return nil
+	}
+}
+
+// WithImpersonateServiceAccount makes every API client impersonate serviceAccount,
+// going through the delegates chain when given.
+func WithImpersonateServiceAccount(serviceAccount string, delegates []string) Option {
+	return func(cli *Client) error {
+		cli.impersonateServiceAccount = serviceAccount
+		cli.impersonateServiceAccountDelegates = delegates
+		return nil
+	}
+}
+
+// WithBaseURLTemplate resolves the hostname of every API from template instead of DefaultBaseURLTemplate.
+// The {subdomain} placeholder is replaced by the regional subdomain of each API and {region} by the region.
+func WithBaseURLTemplate(template string) Option {
+	return func(cli *Client) error {
+		if template != "" {
+			cli.baseURLTemplate = template
+		}
+		return nil
+	}
+}
+
+// WithInstance makes the client target the instance-scoped Chronicle API of a Google SecOps instance
+// instead of the legacy Backstory APIs.
+func WithInstance(apiVersion, project, location, instanceID string) Option {
+	return func(cli *Client) error {
+		if apiVersion == APIVersionLegacy {
+			return nil
+		}
+		if !contains(APIVersions, apiVersion) {
+			return fmt.Errorf("api version %s is not valid, valid versions are: %s", apiVersion, APIVersions)
+		}
+		if project == "" || location == "" || instanceID == "" {
+			return fmt.Errorf("project, location and instance ID are required when using api version %s", apiVersion)
+		}
+
+		cli.apiVersion = apiVersion
+		cli.project = project
+		cli.location = location
+		cli.instanceID = instanceID
+		cli.instanceName = instanceName(project, location, instanceID)
+
+		return nil
+	}
+}
+
+// WithCustomerID sets the Chronicle customer ID, required by the Ingestion API.
+func WithCustomerID(customerID string) Option {
+	return func(cli *Client) error {
+		if customerID == "" {
+			return nil
+		}
+		if _, err := uuid.Parse(customerID); err != nil {
+			return fmt.Errorf("customer ID %s is not valid: %s", customerID, err)
+		}
+
+		cli.customerID = customerID
+		return nil
+	}
+}
+
+// WithLogTypeValidation makes ValidateLogType check log types against the catalogue returned by ListLogTypes.
+func WithLogTypeValidation(enabled bool) Option {
+	return func(cli *Client) error {
+		cli.validateLogTypes = enabled
+		return nil
 	}
 }
 
@@ -239,7 +440,16 @@ func WithRequestAttempts(attempts uint) Option {
 	}
 }
 
+// usesInstanceAPI reports whether requests target the instance-scoped Chronicle API.
+func (cli *Client) usesInstanceAPI() bool {
+	return cli.apiVersion != APIVersionLegacy
+}
+
 func (cli *Client) initHTTPClient(scopes []string, accesstoken, credentials, envVariable string) (*http.Client, error) {
+	if cli.usesInstanceAPI() && scopes != nil {
+		scopes = append(append([]string{}, scopes...), instanceAPIScopes...)
+	}
+
 	tokenSource, err := cli.getTokenSource(scopes, accesstoken, credentials, envVariable)
 	if err != nil {
 		return nil, err
@@ -259,12 +469,72 @@ func (cli *Client) initHTTPClient(scopes []string, accesstoken, credentials, env
 	return client, nil
 }
 
+// initAPIClients builds the HTTP client of every API from its credentials.
+// APIs without Application Default Credentials available are not failed on, so that only the APIs actually used by
+// the configuration need credentials: their requests fail with the reason the credentials could not be loaded.
+func (cli *Client) initAPIClients() error {
+	apis := []struct {
+		client      **http.Client
+		credentials *apiCredentials
+	}{
+		{&cli.bigQueryAPIClient, cli.bigQueryAPICredentials},
+		{&cli.backstoryAPIClient, cli.backstoryAPICredentials},
+		{&cli.ingestionAPIClient, cli.ingestionAPICredentials},
+		{&cli.forwarderAPIClient, cli.forwarderAPICredentials},
+	}
+
+	for _, api := range apis {
+		if api.credentials == nil {
+			continue
+		}
+
+		client, err := cli.initHTTPClient(api.credentials.scopes, api.credentials.accessToken, api.credentials.credentials,
+			api.credentials.envVariable)
+		if err != nil {
+			if !api.credentials.defaultCredentials {
+				return err
+			}
+
+			log.Printf("[WARN] Application Default Credentials not available: %s", err)
+			client = &http.Client{Transport: unavailableCredentialsTransport{err: err}}
+		}
+
+		*api.client = client
+	}
+
+	return nil
+}
+
 func (cli *Client) getTokenSource(scopes []string, accesstoken, credentials, envVariable string) (oauth2.TokenSource, error) {
-	creds, err := cli.GetCredentials(scopes, accesstoken, credentials, envVariable)
+	if cli.impersonateServiceAccount == "" {
+		creds, err := cli.GetCredentials(scopes, accesstoken, credentials, envVariable)
+		if err != nil {
+			return nil, fmt.Errorf("%s", err)
+		}
+		return creds.TokenSource, nil
+	}
+
+	creds, err := cli.GetCredentials(impersonationSourceScopes, accesstoken, credentials, envVariable)
 	if err != nil {
 		return nil, fmt.Errorf("%s", err)
 	}
-	return creds.TokenSource, nil
+
+	if len(scopes) == 0 {
+		scopes = defaultClientScopes
+	}
+
+	log.Printf("[INFO] Impersonating service account %s", cli.impersonateServiceAccount)
+	log.Printf("[INFO]   -- Delegates: %s", cli.impersonateServiceAccountDelegates)
+	tokenSource, err := impersonate.CredentialsTokenSource(cli.context, impersonate.CredentialsConfig{
+		TargetPrincipal: cli.impersonateServiceAccount,
+		Scopes:          scopes,
+		Delegates:       cli.impersonateServiceAccountDelegates,
+	}, option.WithTokenSource(creds.TokenSource))
+	if err != nil {
+		return nil, fmt.Errorf("error impersonating service account %s: %s", cli.impersonateServiceAccount, err)
+	}
+
+	return tokenSource, nil
 }
 
 func (cli *Client) GetCredentials(clientScopes []string, accessToken, credentials, envVariable string) (*googleoauth.Credentials, error) {
@@ -318,5 +588,14 @@ func (cli *Client) GetCredentials(clientScopes []string, accessToken, credential
 		return creds, nil
 	}
 
-	return &googleoauth.Credentials{}, fmt.Errorf("error loading credentials: env variable not found: %s", env)
+	// Application Default Credentials cover GOOGLE_APPLICATION_CREDENTIALS (including external account
+	// configurations used by workload identity federation), gcloud user credentials and the metadata server.
+	creds, err := googleoauth.FindDefaultCredentials(cli.context, clientScopes...)
+	if err != nil {
+		return &googleoauth.Credentials{}, fmt.Errorf("error loading credentials: no credentials configured and application default credentials not found: %s", err)
+	}
+
+	log.Printf("[INFO] Authenticating using Application Default Credentials...")
+	log.Printf("[INFO]   -- Scopes: %s", clientScopes)
+	return creds, nil
 }
@@ -2,18 +2,21 @@ package client
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"golang.org/x/time/rate"
 )
 
 type ClientRateLimiters struct {
-	FeedManagementCreateFeed *rate.Limiter
-	FeedManagementGetFeed    *rate.Limiter
-	FeedManagementListFeeds  *rate.Limiter
-	FeedManagementUpdateFeed *rate.Limiter
-	FeedManagementDeleteFeed *rate.Limiter
-	FeedManagementEnableFeed *rate.Limiter
+	FeedManagementCreateFeed          *rate.Limiter
+	FeedManagementGetFeed             *rate.Limiter
+	FeedManagementListFeeds           *rate.Limiter
+	FeedManagementUpdateFeed          *rate.Limiter
+	FeedManagementDeleteFeed          *rate.Limiter
+	FeedManagementEnableFeed          *rate.Limiter
+	FeedManagementGenerateSecret      *rate.Limiter
+	FeedManagementFetchServiceAccount *rate.Limiter
 
 	DetectionCreateRule         *rate.Limiter
 	DetectionCreateRuleVersion  *rate.Limiter
@@ -32,16 +35,38 @@ type ClientRateLimiters struct {
 	ReferenceListsCreateList *rate.Limiter
 	ReferenceListsGetList    *rate.Limiter
 	ReferenceListsUpdateList *rate.Limiter
+
+	ForwarderCreateForwarder        *rate.Limiter
+	ForwarderGetForwarder           *rate.Limiter
+	ForwarderListForwarders         *rate.Limiter
+	ForwarderUpdateForwarder        *rate.Limiter
+	ForwarderDeleteForwarder        *rate.Limiter
+	ForwarderGenerateForwarderFiles *rate.Limiter
+	ForwarderCreateCollector        *rate.Limiter
+	ForwarderGetCollector           *rate.Limiter
+	ForwarderListCollectors         *rate.Limiter
+	ForwarderUpdateCollector        *rate.Limiter
+	ForwarderDeleteCollector        *rate.Limiter
+
+	IngestionCreateUnstructuredLogEntries *rate.Limiter
+	IngestionCreateUDMEvents              *rate.Limiter
+	IngestionCreateEntities               *rate.Limiter
+	IngestionListLogTypes                 *rate.Limiter
+
+	APIKeysCreateKey *rate.Limiter
+	APIKeysDeleteKey *rate.Limiter
 }
 
 func NewClientRateLimiters() *ClientRateLimiters {
 	return &ClientRateLimiters{
-		FeedManagementCreateFeed: rate.NewLimiter(rate.Every(time.Second), 1),
-		FeedManagementGetFeed:    rate.NewLimiter(rate.Every(time.Second), 1),
-		FeedManagementListFeeds:  rate.NewLimiter(rate.Every(time.Second), 1),
-		FeedManagementUpdateFeed: rate.NewLimiter(rate.Every(time.Second), 1),
-		FeedManagementDeleteFeed: rate.NewLimiter(rate.Every(time.Second), 1),
-		FeedManagementEnableFeed: rate.NewLimiter(rate.Every(time.Second), 1),
+		FeedManagementCreateFeed:          rate.NewLimiter(rate.Every(time.Second), 1),
+		FeedManagementGetFeed:             rate.NewLimiter(rate.Every(time.Second), 1),
+		FeedManagementListFeeds:           rate.NewLimiter(rate.Every(time.Second), 1),
+		FeedManagementUpdateFeed:          rate.NewLimiter(rate.Every(time.Second), 1),
+		FeedManagementDeleteFeed:          rate.NewLimiter(rate.Every(time.Second), 1),
+		FeedManagementEnableFeed:          rate.NewLimiter(rate.Every(time.Second), 1),
+		FeedManagementGenerateSecret:      rate.NewLimiter(rate.Every(time.Second), 1),
+		FeedManagementFetchServiceAccount: rate.NewLimiter(rate.Every(time.Second), 1),
 
 		DetectionCreateRule:         rate.NewLimiter(rate.Every(time.Second), 1),
 		DetectionCreateRuleVersion:  rate.NewLimiter(rate.Every(time.Second), 1),
@@ -60,6 +85,26 @@ func NewClientRateLimiters() *ClientRateLimiters {
 		ReferenceListsCreateList: rate.NewLimiter(rate.Every(time.Second), 1),
 		ReferenceListsGetList:    rate.NewLimiter(rate.Every(time.Second), 1),
 		ReferenceListsUpdateList: rate.NewLimiter(rate.Every(time.Second), 1),
+
+		ForwarderCreateForwarder:        rate.NewLimiter(rate.Every(time.Second), 1),
+		ForwarderGetForwarder:           rate.NewLimiter(rate.Every(time.Second), 1),
+		ForwarderListForwarders:         rate.NewLimiter(rate.Every(time.Second), 1),
+		ForwarderUpdateForwarder:        rate.NewLimiter(rate.Every(time.Second), 1),
+		ForwarderDeleteForwarder:        rate.NewLimiter(rate.Every(time.Second), 1),
+		ForwarderGenerateForwarderFiles: rate.NewLimiter(rate.Every(time.Second), 1),
+		ForwarderCreateCollector:        rate.NewLimiter(rate.Every(time.Second), 1),
+		ForwarderGetCollector:           rate.NewLimiter(rate.Every(time.Second), 1),
+		ForwarderListCollectors:         rate.NewLimiter(rate.Every(time.Second), 1),
+		ForwarderUpdateCollector:        rate.NewLimiter(rate.Every(time.Second), 1),
+		ForwarderDeleteCollector:        rate.NewLimiter(rate.Every(time.Second), 1),
+
+		IngestionCreateUnstructuredLogEntries: rate.NewLimiter(rate.Every(time.Second), 1),
+		IngestionCreateUDMEvents:              rate.NewLimiter(rate.Every(time.Second), 1),
+		IngestionCreateEntities:               rate.NewLimiter(rate.Every(time.Second), 1),
+		IngestionListLogTypes:                 rate.NewLimiter(rate.Every(time.Second), 1),
+
+		APIKeysCreateKey: rate.NewLimiter(rate.Every(time.Second), 1),
+		APIKeysDeleteKey: rate.NewLimiter(rate.Every(time.Second), 1),
 	}
 }
 
@@ -69,21 +114,52 @@ const (
 	IngestionAPIEnvVar    = "CHRONICLE_INGESTION_CREDENTIALS"
 	ForwarderAPIEnvVar    = "CHRONICLE_FORWARDER_CREDENTIALS"
 	ChronicleRegionEnvVar = "CHRONICLE_REGION"
+
+	ImpersonateServiceAccountEnvVar = "CHRONICLE_IMPERSONATE_SERVICE_ACCOUNT"
+
+	BaseURLTemplateEnvVar = "CHRONICLE_BASE_URL_TEMPLATE"
+
+	APIVersionEnvVar = "CHRONICLE_API_VERSION"
+	ProjectEnvVar    = "CHRONICLE_PROJECT"
+	LocationEnvVar   = "CHRONICLE_LOCATION"
+	InstanceIDEnvVar = "CHRONICLE_INSTANCE_ID"
+
+	CustomerIDEnvVar = "CHRONICLE_CUSTOMER_ID"
+
+	ValidateLogTypesEnvVar = "CHRONICLE_VALIDATE_LOG_TYPES"
 )
 
 var EnvAPICrendetialsVar = []string{BigQueryAPIEnvVar, BackstoryAPIEnvVar, IngestionAPIEnvVar, ForwarderAPIEnvVar}
 
 const (
-	RegionUS             = "us"
-	RegionEurope         = "europe"
-	RegionEuropeWest2    = "europe-west2"
-	RegionAsiaSouthEast1 = "asia-southeast1"
+	RegionUS                     = "us"
+	RegionEurope                 = "europe"
+	RegionEuropeWest2            = "europe-west2"
+	RegionEuropeWest3            = "europe-west3"
+	RegionEuropeWest6            = "europe-west6"
+	RegionAsiaSouthEast1         = "asia-southeast1"
+	RegionAsiaNorthEast1         = "asia-northeast1"
+	RegionAsiaSouth1             = "asia-south1"
+	RegionAustraliaSouthEast1    = "australia-southeast1"
+	RegionMeWest1                = "me-west1"
+	RegionMeCentral2             = "me-central2"
+	RegionNorthAmericaNorthEast2 = "northamerica-northeast2"
 )
 
-var Regions = []string{RegionUS, RegionEurope, RegionEuropeWest2, RegionAsiaSouthEast1}
+var Regions = []string{RegionUS, RegionEurope, RegionEuropeWest2, RegionEuropeWest3, RegionEuropeWest6, RegionAsiaSouthEast1,
+	RegionAsiaNorthEast1, RegionAsiaSouth1, RegionAustraliaSouthEast1, RegionMeWest1, RegionMeCentral2, RegionNorthAmericaNorthEast2}
 
 const APIDomain = "googleapis.com"
 
+// API versions supported by the client. APIVersionLegacy targets the Backstory APIs,
+// while APIVersionV1Alpha targets the instance-scoped Chronicle API of Google SecOps.
+const (
+	APIVersionLegacy  = "legacy"
+	APIVersionV1Alpha = "v1alpha"
+)
+
+var APIVersions = []string{APIVersionLegacy, APIVersionV1Alpha}
+
 const (
 	SearchAPIKey          = "SerachAPI"
 	DetectionEngineAPIKey = "DetectionEngineAPI"
@@ -92,51 +168,70 @@ const (
 	GCTIAPIKey            = "GCTIAPI"
 	RBACAPIKey            = "RBACAPI"
 	ReferenceListsAPIKey  = "ReferenceListsAPI"
+	ForwarderAPIKey       = "ForwarderAPI"
+	ChronicleAPIKey       = "ChronicleAPI"
+	ResourceManagerAPIKey = "ResourceManagerAPI"
+	APIKeysAPIKey         = "APIKeysAPI"
 )
 
-var RegionalSubDomains = map[string]map[string]string{
-	SearchAPIKey: {
-		RegionUS:             "backstory",
-		RegionEurope:         "europe-backstory",
-		RegionEuropeWest2:    "europe-west2-backstory",
-		RegionAsiaSouthEast1: "asia-southeast1-backstory",
-	},
-	DetectionEngineAPIKey: {
-		RegionUS:             "backstory",
-		RegionEurope:         "europe-backstory",
-		RegionEuropeWest2:    "europe-west2-backstory",
-		RegionAsiaSouthEast1: "asia-southeast1-backstory",
-	},
-	FeedManagementAPIKey: {
-		RegionUS:             "backstory",
-		RegionEurope:         "europe-backstory",
-		RegionEuropeWest2:    "europe-west2-backstory",
-		RegionAsiaSouthEast1: "asia-southeast1-backstory",
-	},
-	IngestionAPIKey: {
-		RegionUS:             "malachiteingestion-pa",
-		RegionEurope:         "europe-malachiteingestion-pa",
-		RegionEuropeWest2:    "europe-west2-malachiteingestion-pa",
-		RegionAsiaSouthEast1: "asia-southeast1-malachiteingestion-pa",
-	},
-	GCTIAPIKey: {
-		RegionUS:             "backstory",
-		RegionEurope:         "backstory",
-		RegionEuropeWest2:    "backstory",
-		RegionAsiaSouthEast1: "backstory",
-	},
-	RBACAPIKey: {
-		RegionUS:             "backstory",
-		RegionEurope:         "europe-backstory",
-		RegionEuropeWest2:    "europe-west2-backstory",
-		RegionAsiaSouthEast1: "asia-southeast1-backstory",
-	},
-	ReferenceListsAPIKey: {
-		RegionUS:             "backstory",
-		RegionEurope:         "europe-backstory",
-		RegionEuropeWest2:    "europe-west2-backstory",
-		RegionAsiaSouthEast1: "asia-southeast1-backstory",
-	},
+// ChronicleAPIService is the service name of the instance-scoped Chronicle API, as restricted to by API keys.
+const ChronicleAPIService = "chronicle.googleapis.com"
+
+// apiServices maps every API to the service name its hostnames are built from.
+var apiServices = map[string]string{
+	SearchAPIKey:          "backstory",
+	DetectionEngineAPIKey: "backstory",
+	FeedManagementAPIKey:  "backstory",
+	IngestionAPIKey:       "malachiteingestion-pa",
+	GCTIAPIKey:            "backstory",
+	RBACAPIKey:            "backstory",
+	ReferenceListsAPIKey:  "backstory",
+	ForwarderAPIKey:       "backstory",
+	ChronicleAPIKey:       "chronicle",
+	ResourceManagerAPIKey: "cloudresourcemanager",
+	APIKeysAPIKey:         "apikeys",
+}
+
+// globalAPIs are served from the same hostname whatever the region.
+var globalAPIs = []string{GCTIAPIKey, ResourceManagerAPIKey, APIKeysAPIKey}
+
+// DefaultBaseURLTemplate is the template hostnames are resolved from. The {subdomain} placeholder
+// is replaced by the regional subdomain of an API, e.g. europe-backstory, and {region} by the region itself.
+const DefaultBaseURLTemplate = "https://{subdomain}." + APIDomain
+
+const (
+	baseURLTemplateSubDomainPlaceholder = "{subdomain}"
+	baseURLTemplateRegionPlaceholder    = "{region}"
+)
+
+// IsValidRegion reports whether hostnames can be resolved for region.
+func IsValidRegion(region string) bool {
+	return contains(Regions, region)
+}
+
+// RegionalSubDomain returns the subdomain serving an API in a region: the US region of the legacy APIs uses
+// the bare service name (backstory), while every other region prefixes it with the region name (europe-west2-backstory).
+// The Chronicle API always prefixes the service name with its location (us-chronicle).
+func RegionalSubDomain(apiKey, region string) string {
+	service := apiServices[apiKey]
+	if contains(globalAPIs, apiKey) || region == "" || (region == RegionUS && apiKey != ChronicleAPIKey) {
+		return service
+	}
+
+	return fmt.Sprintf("%s-%s", region, service)
+}
+
+// ResolveBaseURL resolves the base URL of an API in a region from baseURLTemplate,
+// falling back to DefaultBaseURLTemplate when it's empty.
+func ResolveBaseURL(baseURLTemplate, apiKey, region string) string {
+	if baseURLTemplate == "" {
+		baseURLTemplate = DefaultBaseURLTemplate
+	}
+
+	return strings.NewReplacer(
+		baseURLTemplateSubDomainPlaceholder, RegionalSubDomain(apiKey, region),
+		baseURLTemplateRegionPlaceholder, region,
+	).Replace(strings.TrimSuffix(baseURLTemplate, "/"))
 }
 
 const (
@@ -153,28 +248,66 @@ const (
 	SubjectsBasePathKey = "Subjects"
 
 	ReferenceListsPathKey = "ReferenceLists"
+
+	ForwarderBasePathKey = "Forwarders"
+
+	IngestionBasePathKey = "Ingestion"
+	LogTypesBasePathKey  = "LogTypes"
+
+	APIKeysBasePathKey = "APIKeys"
+
+	InstanceBasePathKey = "Instance"
 )
 
 func GenerateDefaultBasePaths(region string) map[string]string {
-	var DefaultBasePaths = map[string]string{
-		EventsBasePathKey:   getBasePathFromDomainsAndPath("/v1/events", RegionalSubDomains[SearchAPIKey][region]),
-		AlertBasePathKey:    getBasePathFromDomainsAndPath("/v1/alert", RegionalSubDomains[SearchAPIKey][region]),
-		ArtifactBasePathKey: getBasePathFromDomainsAndPath("/v1/artifact", RegionalSubDomains[SearchAPIKey][region]),
-		AliasBasePathKey:    getBasePathFromDomainsAndPath("/v1/alias", RegionalSubDomains[SearchAPIKey][region]),
-		AssetBasePathKey:    getBasePathFromDomainsAndPath("/v1/asset", RegionalSubDomains[SearchAPIKey][region]),
-		IOCBasePathKey:      getBasePathFromDomainsAndPath("/v1/ioc", RegionalSubDomains[SearchAPIKey][region]),
+	return GenerateBasePaths(DefaultBaseURLTemplate, region)
+}
+
+// GenerateBasePaths returns the base paths of the legacy APIs in a region, resolving hostnames from baseURLTemplate.
+func GenerateBasePaths(baseURLTemplate, region string) map[string]string {
+	return map[string]string{
+		EventsBasePathKey:   ResolveBaseURL(baseURLTemplate, SearchAPIKey, region) + "/v1/events",
+		AlertBasePathKey:    ResolveBaseURL(baseURLTemplate, SearchAPIKey, region) + "/v1/alert",
+		ArtifactBasePathKey: ResolveBaseURL(baseURLTemplate, SearchAPIKey, region) + "/v1/artifact",
+		AliasBasePathKey:    ResolveBaseURL(baseURLTemplate, SearchAPIKey, region) + "/v1/alias",
+		AssetBasePathKey:    ResolveBaseURL(baseURLTemplate, SearchAPIKey, region) + "/v1/asset",
+		IOCBasePathKey:      ResolveBaseURL(baseURLTemplate, SearchAPIKey, region) + "/v1/ioc",
+
+		RuleBasePathKey:           ResolveBaseURL(baseURLTemplate, SearchAPIKey, region) + "/v2/detect/rules",
+		FeedManagementBasePathKey: ResolveBaseURL(baseURLTemplate, FeedManagementAPIKey, region) + "/v1/feeds",
 
-		RuleBasePathKey:           getBasePathFromDomainsAndPath("/v2/detect/rules", RegionalSubDomains[SearchAPIKey][region]),
-		FeedManagementBasePathKey: getBasePathFromDomainsAndPath("/v1/feeds", RegionalSubDomains[FeedManagementAPIKey][region]),
+		SubjectsBasePathKey: ResolveBaseURL(baseURLTemplate, RBACAPIKey, region) + "/v1/subjects",
 
-		SubjectsBasePathKey: getBasePathFromDomainsAndPath("/v1/subjects", RegionalSubDomains[RBACAPIKey][region]),
+		ReferenceListsPathKey: ResolveBaseURL(baseURLTemplate, ReferenceListsAPIKey, region) + "/v2/lists",
 
-		ReferenceListsPathKey: getBasePathFromDomainsAndPath("/v2/lists", RegionalSubDomains[ReferenceListsAPIKey][region]),
+		ForwarderBasePathKey: ResolveBaseURL(baseURLTemplate, ForwarderAPIKey, region) + "/v2/forwarders",
+
+		IngestionBasePathKey: ResolveBaseURL(baseURLTemplate, IngestionAPIKey, region) + "/v2",
+		LogTypesBasePathKey:  ResolveBaseURL(baseURLTemplate, IngestionAPIKey, region) + "/v2/logtypes",
+
+		APIKeysBasePathKey: ResolveBaseURL(baseURLTemplate, APIKeysAPIKey, region) + "/v2",
 	}
+}
 
-	return DefaultBasePaths
+// GenerateInstanceBasePaths returns the base paths of the instance-scoped Chronicle API,
+// e.g. https://eu-chronicle.googleapis.com/v1alpha/projects/{project}/locations/eu/instances/{instance}/feeds.
+// Subjects are managed through the IAM policy of the project, e.g. https://cloudresourcemanager.googleapis.com/v1/projects/{project}.
+func GenerateInstanceBasePaths(baseURLTemplate, apiVersion, project, location, instanceID string) map[string]string {
+	instanceBasePath := fmt.Sprintf("%s/%s/%s", ResolveBaseURL(baseURLTemplate, ChronicleAPIKey, location), apiVersion,
+		instanceName(project, location, instanceID))
+
+	return map[string]string{
+		RuleBasePathKey:           instanceBasePath + "/rules",
+		FeedManagementBasePathKey: instanceBasePath + "/feeds",
+		ReferenceListsPathKey:     instanceBasePath + "/referenceLists",
+		ForwarderBasePathKey:      instanceBasePath + "/forwarders",
+		LogTypesBasePathKey:       instanceBasePath + "/logTypes",
+		InstanceBasePathKey:       instanceBasePath,
+		// RBAC subjects are IAM role bindings of the instance project.
+		SubjectsBasePathKey: ResolveBaseURL(baseURLTemplate, ResourceManagerAPIKey, location) + "/v1/projects/" + project,
+	}
 }
 
-func getBasePathFromDomainsAndPath(basePath string, domain string) string {
-	return fmt.Sprintf("https://%s.%s%s", domain, APIDomain, basePath)
+func instanceName(project, location, instanceID string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/instances/%s", project, location, instanceID)
 }
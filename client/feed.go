@@ -5,18 +5,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 
 	"github.com/pkg/errors"
 )
 
 const (
-	FeedSourceTypeAPI            = "API"
-	FeedSourceTypeAzureBlobStore = "AZURE_BLOBSTORE"
-	FeedSourceTypeGCS            = "GOOGLE_CLOUD_STORAGE"
-	FeedSourceTypeS3             = "AMAZON_S3"
-	FeedSourceTypeSQS            = "AMAZON_SQS"
-	FeedSourceTypeHTTP           = "HTTP"
+	FeedSourceTypeAPI                   = "API"
+	FeedSourceTypeAzureBlobStore        = "AZURE_BLOBSTORE"
+	FeedSourceTypeAzureEventHub         = "AZURE_EVENT_HUB"
+	FeedSourceTypeGCS                   = "GOOGLE_CLOUD_STORAGE"
+	FeedSourceTypeGCSV2                 = "GOOGLE_CLOUD_STORAGE_V2"
+	FeedSourceTypeS3                    = "AMAZON_S3"
+	FeedSourceTypeSQS                   = "AMAZON_SQS"
+	FeedSourceTypeHTTP                  = "HTTP"
+	FeedSourceTypeWebhook               = "HTTPS_PUSH_WEBHOOK"
+	FeedSourceTypePubSubPush            = "HTTPS_PUSH_GOOGLE_CLOUD_PUBSUB"
+	FeedSourceTypeAmazonKinesisFirehose = "HTTPS_PUSH_AMAZON_KINESIS_FIREHOSE"
 )
 
 type ConcreteFeedConfiguration interface {
@@ -29,6 +33,20 @@ type BaseFeed struct {
 	DisplayName string      `json:"display_name,omitempty"`
 	Details     FeedDetails `json:"details,omitempty"`
 	State       string      `json:"feedState,omitempty"`
+
+	// Status of the last run of the feed, only ever returned by the API.
+	FailureMsg                  string              `json:"failureMsg,omitempty"`
+	FailureDetails              *FeedFailureDetails `json:"failureDetails,omitempty"`
+	LastFeedInitiationTime      string              `json:"lastFeedInitiationTime,omitempty"`
+	LastSuccessfulIngestionTime string              `json:"lastSuccessfulIngestionTime,omitempty"`
+}
+
+// FeedFailureDetails explains why the last run of a feed failed.
+type FeedFailureDetails struct {
+	ErrorCode     string `json:"errorCode,omitempty"`
+	HTTPErrorCode int    `json:"httpErrorCode,omitempty"`
+	ErrorCause    string `json:"errorCause,omitempty"`
+	ErrorAction   string `json:"errorAction,omitempty"`
 }
 
 type FeedDetails struct {
@@ -36,6 +54,20 @@ type FeedDetails struct {
 	LogType    string  `json:"logType,omitempty"`
 	Namespace  string  `json:"namespace,omitempty"`
 	Labels     []Label `json:"labels,omitempty"`
+	FeedScheduling
+}
+
+// FeedScheduling controls when a pull feed fetches data. Feeds without scheduling use the default polling schedule of Chronicle.
+type FeedScheduling struct {
+	Schedule *FeedSchedule `json:"schedule,omitempty"`
+	// BackfillStartTime is the time, in RFC 3339 format, from which data is ingested when the feed first runs.
+	BackfillStartTime string `json:"backfillStartTime,omitempty"`
+}
+
+// FeedSchedule is either an interval, as a duration in seconds such as 3600s, or a cron expression.
+type FeedSchedule struct {
+	Interval       string `json:"interval,omitempty"`
+	CronExpression string `json:"cronExpression,omitempty"`
 }
 
 type Label struct {
@@ -56,15 +88,16 @@ func expandFeedFromFeedMap(configurationPropertyKey string, feedMap map[string]i
 }
 
 func newFeedAsMapFromConcreteFeed(name, displayName, logType, namespace string,
-	labels []Label, concreteFeed ConcreteFeedConfiguration) (map[string]interface{}, error) {
+	labels []Label, scheduling FeedScheduling, concreteFeed ConcreteFeedConfiguration) (map[string]interface{}, error) {
 	feed := BaseFeed{
 		Name:        name,
 		DisplayName: displayName,
 		Details: FeedDetails{
-			LogType:    logType,
-			SourceType: concreteFeed.getFeedSourceType(),
-			Namespace:  namespace,
-			Labels:     labels,
+			LogType:        logType,
+			SourceType:     concreteFeed.getFeedSourceType(),
+			Namespace:      namespace,
+			Labels:         labels,
+			FeedScheduling: scheduling,
 		},
 	}
 
@@ -92,13 +125,19 @@ func joinBaseFeedMapAndConcreteFeedMap(configurationPropertyKey string, baseFeed
 	return baseFeedMap
 }
 
-func (cli *Client) CreateFeed(displayName, logType, namespace string, labels []Label, concreteFeedConfiguration ConcreteFeedConfiguration) (string, error) {
-	feed, err := newFeedAsMapFromConcreteFeed("", displayName, logType, namespace, labels, concreteFeedConfiguration)
+func (cli *Client) CreateFeed(displayName, logType, namespace string, labels []Label, scheduling FeedScheduling,
+	concreteFeedConfiguration ConcreteFeedConfiguration) (string, error) {
+	feed, err := newFeedAsMapFromConcreteFeed("", displayName, logType, namespace, labels, scheduling, concreteFeedConfiguration)
 	if err != nil {
 		return "", errors.Wrap(err, "failed generating feed")
 	}
 
 	url := cli.FeedManagementBasePath
+	if cli.usesInstanceAPI() {
+		if feed, err = cli.toInstanceFeedMap(feed); err != nil {
+			return "", errors.Wrap(err, "failed generating feed")
+		}
+	}
 
 	err = cli.rateLimiters.FeedManagementCreateFeed.Wait(context.Background())
 	if err != nil {
@@ -121,8 +160,8 @@ func (cli *Client) CreateFeed(displayName, logType, namespace string, labels []L
 	return name, nil
 }
 
-func (cli *Client) UpdateFeed(name, displayName, logType, namespace string, labels []Label, conf ConcreteFeedConfiguration) error {
-	feed, err := newFeedAsMapFromConcreteFeed(name, displayName, logType, namespace, labels, conf)
+func (cli *Client) UpdateFeed(name, displayName, logType, namespace string, labels []Label, scheduling FeedScheduling, conf ConcreteFeedConfiguration) error {
+	feed, err := newFeedAsMapFromConcreteFeed(name, displayName, logType, namespace, labels, scheduling, conf)
 	if err != nil {
 		return errors.Wrap(err, "failed updating feed")
 	}
@@ -133,6 +172,11 @@ func (cli *Client) UpdateFeed(name, displayName, logType, namespace string, labe
 	}
 
 	url := fmt.Sprintf("%s/%s", cli.FeedManagementBasePath, name)
+	if cli.usesInstanceAPI() {
+		if feed, err = cli.toInstanceFeedMap(feed); err != nil {
+			return errors.Wrap(err, "failed updating feed")
+		}
+	}
 
 	err = cli.rateLimiters.FeedManagementUpdateFeed.Wait(context.Background())
 	if err != nil {
@@ -148,37 +192,129 @@ func (cli *Client) UpdateFeed(name, displayName, logType, namespace string, labe
 }
 
 func (cli *Client) ReadFeed(name string) (*BaseFeed, *ConcreteFeedConfiguration, error) {
+	result, err := cli.readFeedMap(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	concreteFeed := concreteFeedConfigurationFromDetails(result["details"].(map[string]interface{}))
+
+	var baseFeed *BaseFeed
+	baseFeed, concreteFeed, err = expandFeedFromFeedMap(concreteFeed.getConfigurationPropertyKey(), result)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed generating feed")
+	}
+
+	baseFeed.Name = parseFeedID(baseFeed.Name)
+
+	return baseFeed, &concreteFeed, nil
+}
+
+// ReadGenericFeed returns a feed along with its source settings as returned by the API, e.g. the
+// content of "amazonS3Settings". Unlike ReadFeed, it does not need to know the feed type.
+func (cli *Client) ReadGenericFeed(name string) (*BaseFeed, *GenericFeedConfiguration, error) {
+	result, err := cli.readFeedMap(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	baseFeed, err := baseFeedFromFeedMap(result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	details := result["details"].(map[string]interface{})
+	conf := newGenericFeedConfigurationFromDetails(details)
+	conf.Settings, _ = details[conf.PropertyKey].(map[string]interface{})
+
+	return baseFeed, conf, nil
+}
+
+// ListFeeds returns every feed of the customer. Source settings are left out, as some feed types are not known to the client.
+func (cli *Client) ListFeeds() ([]BaseFeed, error) {
+	feeds := make([]BaseFeed, 0)
+
+	pageToken := ""
+	for {
+		url := withPageToken(cli.FeedManagementBasePath, pageToken)
+
+		err := cli.rateLimiters.FeedManagementListFeeds.Wait(context.Background())
+		if err != nil {
+			return nil, errors.Wrap(err, "Error waiting for rateLimiter while listing feeds")
+		}
+
+		res, err := sendRequest(cli, cli.backstoryAPIClient, "GET", cli.userAgent, url, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed listing feeds")
+		}
+
+		var page struct {
+			Feeds         []map[string]interface{} `json:"feeds"`
+			NextPageToken string                   `json:"nextPageToken"`
+		}
+		if err := json.Unmarshal(res, &page); err != nil {
+			return nil, errors.Wrap(err, "failed decoding feeds")
+		}
+
+		for _, feedMap := range page.Feeds {
+			if cli.usesInstanceAPI() {
+				if feedMap, err = cli.fromInstanceFeedMap(feedMap); err != nil {
+					return nil, errors.Wrap(err, "failed decoding feeds")
+				}
+			}
+
+			baseFeed, err := baseFeedFromFeedMap(feedMap)
+			if err != nil {
+				return nil, err
+			}
+			feeds = append(feeds, *baseFeed)
+		}
+
+		if page.NextPageToken == "" {
+			return feeds, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+func (cli *Client) readFeedMap(name string) (map[string]interface{}, error) {
 	url := fmt.Sprintf("%s/%s", cli.FeedManagementBasePath, name)
 
 	err := cli.rateLimiters.FeedManagementGetFeed.Wait(context.Background())
 	if err != nil {
-		return nil, nil, errors.Wrap(err, fmt.Sprintf("Error Waiting for rateLimiter while reading feed %s", name))
+		return nil, errors.Wrap(err, fmt.Sprintf("Error Waiting for rateLimiter while reading feed %s", name))
 	}
 	res, err := sendRequest(cli, cli.backstoryAPIClient, "GET", cli.userAgent, url, nil)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "failed reading feed")
+		return nil, errors.Wrap(err, "failed reading feed")
 	}
 
 	reader := bytes.NewReader(res)
 	result := make(map[string]interface{})
 	if err := json.NewDecoder(reader).Decode(&result); err != nil {
-		return nil, nil, errors.Wrap(err, "failed decoding feed")
+		return nil, errors.Wrap(err, "failed decoding feed")
+	}
+	if cli.usesInstanceAPI() {
+		if result, err = cli.fromInstanceFeedMap(result); err != nil {
+			return nil, errors.Wrap(err, "failed decoding feed")
+		}
 	}
-	details := result["details"].(map[string]interface{})
 
-	feedSourceType := extractFeedSourceTypeFromDetails(details)
-	logType := extractLogTypeFromDetails(details)
-	concreteFeed := newConcreteFeedConfiguration(feedSourceType, logType)
+	return result, nil
+}
 
-	var baseFeed *BaseFeed
-	baseFeed, concreteFeed, err = expandFeedFromFeedMap(concreteFeed.getConfigurationPropertyKey(), result)
+func baseFeedFromFeedMap(feedMap map[string]interface{}) (*BaseFeed, error) {
+	var baseFeed BaseFeed
+	baseFeedBytes, err := json.Marshal(feedMap)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "failed generating feed")
+		return nil, errors.Wrap(err, "failed generating feed")
+	}
+	err = json.Unmarshal(baseFeedBytes, &baseFeed)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed generating feed")
 	}
-
 	baseFeed.Name = parseFeedID(baseFeed.Name)
 
-	return baseFeed, &concreteFeed, nil
+	return &baseFeed, nil
 }
 
 func (cli *Client) DestroyFeed(name string) error {
@@ -220,6 +356,75 @@ func (cli *Client) ChangeEnableFeed(id string, enabled bool) error {
 	return nil
 }
 
+// GenerateFeedSecret generates a new secret for a push feed, e.g. a webhook. Any previous secret stops working.
+func (cli *Client) GenerateFeedSecret(id string) (string, error) {
+	url := fmt.Sprintf("%s/%s:generateSecret", cli.FeedManagementBasePath, id)
+
+	err := cli.rateLimiters.FeedManagementGenerateSecret.Wait(context.Background())
+	if err != nil {
+		return "", errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while generating secret for feed %s", id))
+	}
+
+	res, err := sendRequest(cli, cli.backstoryAPIClient, "POST", cli.userAgent, url, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed generating feed secret")
+	}
+
+	var result struct {
+		Secret string `json:"secret"`
+	}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return "", errors.Wrap(err, "failed decoding feed secret")
+	}
+
+	return result.Secret, nil
+}
+
+// FetchFeedServiceAccount returns the email of the service account Chronicle reads Google Cloud sources as,
+// e.g. GCS V2 buckets, which needs to be granted access to them.
+func (cli *Client) FetchFeedServiceAccount() (string, error) {
+	url := fmt.Sprintf("%s:fetchServiceAccountForCustomer", cli.FeedManagementBasePath)
+
+	err := cli.rateLimiters.FeedManagementFetchServiceAccount.Wait(context.Background())
+	if err != nil {
+		return "", errors.Wrap(err, "Error waiting for rateLimiter while fetching feed service account")
+	}
+
+	res, err := sendRequest(cli, cli.backstoryAPIClient, "GET", cli.userAgent, url, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed fetching feed service account")
+	}
+
+	var result struct {
+		ServiceAccount string `json:"serviceAccount"`
+	}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return "", errors.Wrap(err, "failed decoding feed service account")
+	}
+
+	return result.ServiceAccount, nil
+}
+
+// CheckPushFeedsSupported returns an error unless the client targets the instance-scoped Chronicle API, which owns the
+// endpoint push feeds receive data on.
+func (cli *Client) CheckPushFeedsSupported() error {
+	if !cli.usesInstanceAPI() {
+		return fmt.Errorf("push feeds receive data on an endpoint of the instance-scoped Chronicle API, "+
+			"set api_version to %s together with project, location and instance_id in the provider configuration", APIVersionV1Alpha)
+	}
+
+	return nil
+}
+
+// PushFeedEndpointURL returns the endpoint push feeds receive data on.
+func (cli *Client) PushFeedEndpointURL(id string) (string, error) {
+	if err := cli.CheckPushFeedsSupported(); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/%s:importPushLogs", cli.FeedManagementBasePath, id), nil
+}
+
 func fromFeedMapToBaseFeedAndConcreteConfiguration(configurationPropertyKey string, feedMap map[string]interface{}) (*BaseFeed, ConcreteFeedConfiguration, error) {
 	details := feedMap["details"].(map[string]interface{})
 
@@ -238,9 +443,7 @@ func fromFeedMapToBaseFeedAndConcreteConfiguration(configurationPropertyKey stri
 		return nil, nil, errors.Wrap(err, "failed generating feed")
 	}
 
-	feedSourceType := extractFeedSourceTypeFromDetails(details)
-	logType := extractLogTypeFromDetails(details)
-	concreteFeed := newConcreteFeedConfiguration(feedSourceType, logType)
+	concreteFeed := concreteFeedConfigurationFromDetails(details)
 	err = json.Unmarshal(concreteFeedBytes, &concreteFeed)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed generating feed")
@@ -249,47 +452,39 @@ func fromFeedMapToBaseFeedAndConcreteConfiguration(configurationPropertyKey stri
 	return baseFeed, concreteFeed, nil
 }
 
+// parseFeedID returns the feed ID from either a feeds/{id} or a projects/.../instances/{instance}/feeds/{id} name.
 func parseFeedID(idRaw string) string {
-	return strings.ReplaceAll(idRaw, "feeds/", "")
+	return lastPathSegment(idRaw)
 }
 
-func newConcreteFeedConfiguration(feedSourceType, logType string) ConcreteFeedConfiguration {
-	switch feedSourceType {
-	case FeedSourceTypeS3:
-		return &S3FeedConfiguration{}
-	case FeedSourceTypeSQS:
-		return &SQSFeedConfiguration{}
-	case FeedSourceTypeAPI:
-		return newAPIConcreteFeedConfigurationFromLogType(logType)
-	case FeedSourceTypeGCS:
-		return &GCPBucketFeedConfiguration{}
-	case FeedSourceTypeAzureBlobStore:
-		return &AzureBlobStoreFeedConfiguration{}
-
-	default:
-		return nil
+// concreteFeedConfigurationFromDetails returns an empty configuration matching the type of a feed,
+// falling back to a GenericFeedConfiguration for types the client does not know.
+func concreteFeedConfigurationFromDetails(details map[string]interface{}) ConcreteFeedConfiguration {
+	if concreteFeed := newConcreteFeedConfiguration(extractFeedSourceTypeFromDetails(details), extractLogTypeFromDetails(details)); concreteFeed != nil {
+		return concreteFeed
 	}
-}
-
-func newAPIConcreteFeedConfigurationFromLogType(logType string) ConcreteFeedConfiguration {
-	switch logType {
-	case MicrosoftOffice365ManagementActivityFeedLogType:
-		return &MicrosoftOffice365ManagementActivityFeedConfiguration{}
-
-	case OktaUsersFeedLogType:
-		return &OktaUsersFeedConfiguration{}
 
-	case QualysVMFeedLogType:
-		return &QualysVMFeedConfiguration{}
-
-	case OktaSystemLogFeedLogType:
-		return &OktaSystemLogFeedConfiguration{}
+	return newGenericFeedConfigurationFromDetails(details)
+}
 
-	case ProofpointSIEMFeedLogType:
-		return &ProofpointSIEMFeedConfiguration{}
+func newConcreteFeedConfiguration(feedSourceType, logType string) ConcreteFeedConfiguration {
+	if feedType, ok := lookupRegisteredFeedType(feedSourceType, logType); ok {
+		return &RegisteredFeedConfiguration{FeedType: feedType}
+	}
 
-	case ThinkstCanaryFeedLogType:
-		return &ThinkstCanaryFeedConfiguration{}
+	switch feedSourceType {
+	case FeedSourceTypeGCSV2:
+		return &GCSV2FeedConfiguration{}
+	case FeedSourceTypeAzureEventHub:
+		return &AzureEventHubFeedConfiguration{}
+	case FeedSourceTypeHTTP:
+		return &HTTPFeedConfiguration{}
+	case FeedSourceTypeWebhook:
+		return &WebhookFeedConfiguration{}
+	case FeedSourceTypePubSubPush:
+		return &PubSubPushFeedConfiguration{}
+	case FeedSourceTypeAmazonKinesisFirehose:
+		return &AmazonKinesisFirehoseFeedConfiguration{}
 
 	default:
 		return nil
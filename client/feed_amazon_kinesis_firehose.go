@@ -0,0 +1,16 @@
+package client
+
+const (
+	amazonKinesisFirehoseFeedConfigurationPropertyKey = "httpsPushAmazonKinesisFirehoseSettings"
+)
+
+type AmazonKinesisFirehoseFeedConfiguration struct {
+	SplitDelimiter string `json:"splitDelimiter,omitempty"`
+}
+
+func (c *AmazonKinesisFirehoseFeedConfiguration) getConfigurationPropertyKey() string {
+	return amazonKinesisFirehoseFeedConfigurationPropertyKey
+}
+func (c *AmazonKinesisFirehoseFeedConfiguration) getFeedSourceType() string {
+	return FeedSourceTypeAmazonKinesisFirehose
+}
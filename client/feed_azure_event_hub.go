@@ -0,0 +1,20 @@
+package client
+
+const (
+	azureEventHubFeedConfigurationPropertyKey = "azureEventHubSettings"
+)
+
+type AzureEventHubFeedConfiguration struct {
+	Name                    string `json:"name,omitempty"`
+	ConsumerGroup           string `json:"consumerGroup,omitempty"`
+	ConnectionString        string `json:"eventHubConnectionString,omitempty"`
+	StorageConnectionString string `json:"azureStorageConnectionString,omitempty"`
+	StorageContainer        string `json:"azureStorageContainer,omitempty"`
+}
+
+func (c *AzureEventHubFeedConfiguration) getConfigurationPropertyKey() string {
+	return azureEventHubFeedConfigurationPropertyKey
+}
+func (c *AzureEventHubFeedConfiguration) getFeedSourceType() string {
+	return FeedSourceTypeAzureEventHub
+}
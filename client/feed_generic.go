@@ -0,0 +1,45 @@
+package client
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// GenericFeedConfiguration holds the settings of any feed type as a raw map, keyed by PropertyKey
+// (e.g. "httpSettings") in the feed details. It backs feed types the client has no dedicated configuration for.
+type GenericFeedConfiguration struct {
+	PropertyKey string
+	SourceType  string
+	Settings    map[string]interface{}
+}
+
+func (g *GenericFeedConfiguration) getConfigurationPropertyKey() string {
+	return g.PropertyKey
+}
+
+func (g *GenericFeedConfiguration) getFeedSourceType() string {
+	return g.SourceType
+}
+
+func (g *GenericFeedConfiguration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.Settings)
+}
+
+func (g *GenericFeedConfiguration) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &g.Settings)
+}
+
+// newGenericFeedConfigurationFromDetails finds the settings of a feed in its details, where they are the only
+// object whose key ends with "Settings".
+func newGenericFeedConfigurationFromDetails(details map[string]interface{}) *GenericFeedConfiguration {
+	conf := &GenericFeedConfiguration{
+		SourceType: extractFeedSourceTypeFromDetails(details),
+	}
+	for k, v := range details {
+		if _, ok := v.(map[string]interface{}); ok && strings.HasSuffix(k, "Settings") {
+			conf.PropertyKey = k
+		}
+	}
+
+	return conf
+}
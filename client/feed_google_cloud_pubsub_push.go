@@ -0,0 +1,16 @@
+package client
+
+const (
+	pubSubPushFeedConfigurationPropertyKey = "httpsPushGoogleCloudPubsubSettings"
+)
+
+type PubSubPushFeedConfiguration struct {
+	SplitDelimiter string `json:"splitDelimiter,omitempty"`
+}
+
+func (c *PubSubPushFeedConfiguration) getConfigurationPropertyKey() string {
+	return pubSubPushFeedConfigurationPropertyKey
+}
+func (c *PubSubPushFeedConfiguration) getFeedSourceType() string {
+	return FeedSourceTypePubSubPush
+}
@@ -0,0 +1,22 @@
+package client
+
+const (
+	gcsV2FeedConfigurationPropertyKey = "gcsV2Settings"
+)
+
+// GCSV2FeedConfiguration is the configuration of feeds reading from Google Cloud Storage through Storage Transfer,
+// authenticating as the Chronicle service account returned by FetchFeedServiceAccount.
+type GCSV2FeedConfiguration struct {
+	URI                 string   `json:"bucketUri,omitempty"`
+	SourceDeleteOptions string   `json:"sourceDeletionOption,omitempty"`
+	MaxLookbackDays     int      `json:"maxLookbackDays,omitempty"`
+	IncludePrefixes     []string `json:"includePrefixes,omitempty"`
+	ExcludePrefixes     []string `json:"excludePrefixes,omitempty"`
+}
+
+func (c *GCSV2FeedConfiguration) getConfigurationPropertyKey() string {
+	return gcsV2FeedConfigurationPropertyKey
+}
+func (c *GCSV2FeedConfiguration) getFeedSourceType() string {
+	return FeedSourceTypeGCSV2
+}
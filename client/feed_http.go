@@ -0,0 +1,28 @@
+package client
+
+const (
+	httpFeedConfigurationPropertyKey = "httpSettings"
+)
+
+type HTTPFeedConfiguration struct {
+	URI                 string                  `json:"uri,omitempty"`
+	SourceType          string                  `json:"sourceType,omitempty"`
+	SourceDeleteOptions string                  `json:"sourceDeletionOption,omitempty"`
+	Authentication      *HTTPFeedAuthentication `json:"authentication,omitempty"`
+}
+
+type HTTPFeedAuthentication struct {
+	HeaderKeyValues []HTTPAuthenticationHeaderKeyValues `json:"headerKeyValues,omitempty"`
+}
+
+type HTTPAuthenticationHeaderKeyValues struct {
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+func (c *HTTPFeedConfiguration) getConfigurationPropertyKey() string {
+	return httpFeedConfigurationPropertyKey
+}
+func (c *HTTPFeedConfiguration) getFeedSourceType() string {
+	return FeedSourceTypeHTTP
+}
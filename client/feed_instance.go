@@ -0,0 +1,122 @@
+package client
+
+import (
+	"fmt"
+)
+
+// Feeds of the instance-scoped Chronicle API share their settings with the Feed Management API,
+// but the log type is a resource name, the namespace is called asset namespace, labels are a map
+// and the state is named differently. Feeds are converted from one shape to the other so that
+// concrete feed configurations are shared by both APIs.
+
+const (
+	instanceFeedDisplayNameKey    = "displayName"
+	instanceFeedStateKey          = "state"
+	instanceFeedAssetNamespaceKey = "assetNamespace"
+)
+
+// instanceFeedStatusKeys are the fields describing the last run of a feed, named alike by both APIs.
+var instanceFeedStatusKeys = []string{"failureMsg", "failureDetails", "lastFeedInitiationTime", "lastSuccessfulIngestionTime"}
+
+func (cli *Client) toInstanceFeedMap(feedMap map[string]interface{}) (map[string]interface{}, error) {
+	instanceFeedMap := map[string]interface{}{}
+
+	if name, ok := feedMap["name"].(string); ok && name != "" {
+		instanceFeedMap["name"] = fmt.Sprintf("%s/feeds/%s", cli.instanceName, name)
+	}
+	if displayName, ok := feedMap["display_name"]; ok {
+		instanceFeedMap[instanceFeedDisplayNameKey] = displayName
+	}
+
+	feedDetails, ok := feedMap["details"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("cannot get property details from feed")
+	}
+
+	details := map[string]interface{}{}
+	for k, v := range feedDetails {
+		switch k {
+		case "logType":
+			logType, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("feed log type %v is not a string", v)
+			}
+			details[k] = cli.logTypeResourceName(logType)
+		case "namespace":
+			details[instanceFeedAssetNamespaceKey] = v
+		case "labels":
+			labelList, ok := v.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("feed labels %v are not a list", v)
+			}
+			labels := map[string]interface{}{}
+			for _, label := range labelList {
+				labelMap, ok := label.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("feed label %v is not a key and value", label)
+				}
+				labels[fmt.Sprint(labelMap["key"])] = labelMap["value"]
+			}
+			details[k] = labels
+		default:
+			details[k] = v
+		}
+	}
+	instanceFeedMap["details"] = details
+
+	return instanceFeedMap, nil
+}
+
+func (cli *Client) fromInstanceFeedMap(instanceFeedMap map[string]interface{}) (map[string]interface{}, error) {
+	feedMap := map[string]interface{}{}
+
+	if name, ok := instanceFeedMap["name"].(string); ok {
+		feedMap["name"] = name
+	}
+	if displayName, ok := instanceFeedMap[instanceFeedDisplayNameKey]; ok {
+		feedMap["display_name"] = displayName
+	}
+	if state, ok := instanceFeedMap[instanceFeedStateKey]; ok {
+		feedMap["feedState"] = state
+	}
+	for _, key := range instanceFeedStatusKeys {
+		if value, ok := instanceFeedMap[key]; ok {
+			feedMap[key] = value
+		}
+	}
+
+	details := map[string]interface{}{}
+	if instanceDetails, ok := instanceFeedMap["details"].(map[string]interface{}); ok {
+		for k, v := range instanceDetails {
+			switch k {
+			case "logType":
+				logType, ok := v.(string)
+				if !ok {
+					return nil, fmt.Errorf("feed log type %v is not a string", v)
+				}
+				details[k] = lastPathSegment(logType)
+			case instanceFeedAssetNamespaceKey:
+				details["namespace"] = v
+			case "labels":
+				labelMap, ok := v.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("feed labels %v are not a map", v)
+				}
+				labels := make([]interface{}, 0, len(labelMap))
+				for key, value := range labelMap {
+					labels = append(labels, map[string]interface{}{"key": key, "value": value})
+				}
+				details[k] = labels
+			default:
+				details[k] = v
+			}
+		}
+	}
+	feedMap["details"] = details
+
+	return feedMap, nil
+}
+
+func (cli *Client) logTypeResourceName(logType string) string {
+	return fmt.Sprintf("%s/logTypes/%s", cli.instanceName, logType)
+}
@@ -0,0 +1,128 @@
+package client
+
+import (
+	"encoding/json"
+)
+
+// FeedType identifies a feed type of the Feed Management API, whose settings are found under PropertyKey
+// (e.g. "amazonS3Settings") in the feed details. LogType is only set for API feeds, which have a feed type per log type.
+type FeedType struct {
+	SourceType  string
+	LogType     string
+	PropertyKey string
+}
+
+var (
+	FeedTypeAmazonS3                             = FeedType{SourceType: FeedSourceTypeS3, PropertyKey: "amazonS3Settings"}
+	FeedTypeAmazonSQS                            = FeedType{SourceType: FeedSourceTypeSQS, PropertyKey: "amazonSqsSettings"}
+	FeedTypeGoogleCloudStorageBucket             = FeedType{SourceType: FeedSourceTypeGCS, PropertyKey: "gcsSettings"}
+	FeedTypeAzureBlobStore                       = FeedType{SourceType: FeedSourceTypeAzureBlobStore, PropertyKey: "azureBlobStoreSettings"}
+	FeedTypeQualysVM                             = FeedType{SourceType: FeedSourceTypeAPI, LogType: "QUALYS_VM", PropertyKey: "qualysVmSettings"}
+	FeedTypeMicrosoftOffice365ManagementActivity = FeedType{SourceType: FeedSourceTypeAPI, LogType: "OFFICE_365", PropertyKey: "office365Settings"}
+	FeedTypeOktaSystemLog                        = FeedType{SourceType: FeedSourceTypeAPI, LogType: "OKTA", PropertyKey: "oktaSettings"}
+	FeedTypeOktaUsers                            = FeedType{SourceType: FeedSourceTypeAPI, LogType: "OKTA_USER_CONTEXT", PropertyKey: "oktaUserContextSettings"}
+	FeedTypeProofpointSIEM                       = FeedType{SourceType: FeedSourceTypeAPI, LogType: "PROOFPOINT_MAIL", PropertyKey: "proofpointMailSettings"}
+	FeedTypeThinkstCanary                        = FeedType{SourceType: FeedSourceTypeAPI, LogType: "THINKST_CANARY", PropertyKey: "thinkstCanarySettings"}
+	FeedTypeGoogleWorkspaceActivity              = FeedType{SourceType: FeedSourceTypeAPI, LogType: "WORKSPACE_ACTIVITY", PropertyKey: "workspaceActivitySettings"}
+	FeedTypeGoogleWorkspaceUsers                 = FeedType{SourceType: FeedSourceTypeAPI, LogType: "WORKSPACE_USERS", PropertyKey: "workspaceUsersSettings"}
+	FeedTypeGoogleWorkspaceGroups                = FeedType{SourceType: FeedSourceTypeAPI, LogType: "WORKSPACE_GROUPS", PropertyKey: "workspaceGroupsSettings"}
+	FeedTypeGoogleWorkspaceAlerts                = FeedType{SourceType: FeedSourceTypeAPI, LogType: "WORKSPACE_ALERTS", PropertyKey: "workspaceAlertsSettings"}
+	FeedTypeGoogleWorkspaceMobile                = FeedType{SourceType: FeedSourceTypeAPI, LogType: "WORKSPACE_MOBILE", PropertyKey: "workspaceMobileSettings"}
+	FeedTypeGoogleWorkspaceChromeOS              = FeedType{SourceType: FeedSourceTypeAPI, LogType: "WORKSPACE_CHROMEOS", PropertyKey: "workspaceChromeOsSettings"}
+	FeedTypeMicrosoftEntraIDSignIn               = FeedType{SourceType: FeedSourceTypeAPI, LogType: "AZURE_AD", PropertyKey: "azureAdSettings"}
+	FeedTypeMicrosoftEntraIDAudit                = FeedType{SourceType: FeedSourceTypeAPI, LogType: "AZURE_AD_AUDIT", PropertyKey: "azureAdAuditSettings"}
+	FeedTypeMicrosoftEntraIDContext              = FeedType{SourceType: FeedSourceTypeAPI, LogType: "AZURE_AD_CONTEXT", PropertyKey: "azureAdContextSettings"}
+	FeedTypeMicrosoftGraphAlert                  = FeedType{SourceType: FeedSourceTypeAPI, LogType: "MICROSOFT_GRAPH_ALERT", PropertyKey: "microsoftGraphAlertSettings"}
+	FeedTypeCrowdStrikeFalconDetections          = FeedType{SourceType: FeedSourceTypeAPI, LogType: "CS_DETECTS", PropertyKey: "crowdstrikeDetectsSettings"}
+	FeedTypeCrowdStrikeFalconAlerts              = FeedType{SourceType: FeedSourceTypeAPI, LogType: "CS_ALERTS", PropertyKey: "crowdstrikeAlertsSettings"}
+	FeedTypeSentinelOneAlerts                    = FeedType{SourceType: FeedSourceTypeAPI, LogType: "SENTINELONE_ALERT", PropertyKey: "sentineloneAlertSettings"}
+	FeedTypeSentinelOneActivity                  = FeedType{SourceType: FeedSourceTypeAPI, LogType: "SENTINELONE_ACTIVITY", PropertyKey: "sentineloneActivitySettings"}
+	FeedTypeMicrosoftDefenderForEndpoint         = FeedType{SourceType: FeedSourceTypeAPI, LogType: "MICROSOFT_DEFENDER_ENDPOINT", PropertyKey: "microsoftDefenderEndpointSettings"}
+	FeedTypeSalesforce                           = FeedType{SourceType: FeedSourceTypeAPI, LogType: "SALESFORCE", PropertyKey: "salesforceSettings"}
+	FeedTypeDuoAdmin                             = FeedType{SourceType: FeedSourceTypeAPI, LogType: "DUO_ADMIN", PropertyKey: "duoAdminSettings"}
+	FeedTypeSlackAudit                           = FeedType{SourceType: FeedSourceTypeAPI, LogType: "SLACK_AUDIT", PropertyKey: "slackAuditSettings"}
+	FeedTypeBox                                  = FeedType{SourceType: FeedSourceTypeAPI, LogType: "BOX", PropertyKey: "boxSettings"}
+	FeedTypeWorkday                              = FeedType{SourceType: FeedSourceTypeAPI, LogType: "WORKDAY", PropertyKey: "workdaySettings"}
+	FeedTypeGitHubAudit                          = FeedType{SourceType: FeedSourceTypeAPI, LogType: "GITHUB", PropertyKey: "githubSettings"}
+	FeedTypeCloudflareAudit                      = FeedType{SourceType: FeedSourceTypeAPI, LogType: "CLOUDFLARE_AUDIT", PropertyKey: "cloudflareAuditSettings"}
+	FeedTypeZscaler                              = FeedType{SourceType: FeedSourceTypeAPI, LogType: "ZSCALER_ADMIN_AUDIT", PropertyKey: "zscalerAdminAuditSettings"}
+	FeedTypeNetskope                             = FeedType{SourceType: FeedSourceTypeAPI, LogType: "NETSKOPE_ALERT_V2", PropertyKey: "netskopeV2Settings"}
+	FeedTypePaloAltoCortexDataLake               = FeedType{SourceType: FeedSourceTypeAPI, LogType: "PAN_CORTEX_DATA_LAKE", PropertyKey: "cortexDataLakeSettings"}
+	FeedTypeMimecast                             = FeedType{SourceType: FeedSourceTypeAPI, LogType: "MIMECAST_MAIL", PropertyKey: "mimecastMailSettings"}
+)
+
+// registeredFeedTypes are the feed types read as a RegisteredFeedConfiguration.
+var registeredFeedTypes = []FeedType{
+	FeedTypeAmazonS3,
+	FeedTypeAmazonSQS,
+	FeedTypeGoogleCloudStorageBucket,
+	FeedTypeAzureBlobStore,
+	FeedTypeQualysVM,
+	FeedTypeMicrosoftOffice365ManagementActivity,
+	FeedTypeOktaSystemLog,
+	FeedTypeOktaUsers,
+	FeedTypeProofpointSIEM,
+	FeedTypeThinkstCanary,
+	FeedTypeGoogleWorkspaceActivity,
+	FeedTypeGoogleWorkspaceUsers,
+	FeedTypeGoogleWorkspaceGroups,
+	FeedTypeGoogleWorkspaceAlerts,
+	FeedTypeGoogleWorkspaceMobile,
+	FeedTypeGoogleWorkspaceChromeOS,
+	FeedTypeMicrosoftEntraIDSignIn,
+	FeedTypeMicrosoftEntraIDAudit,
+	FeedTypeMicrosoftEntraIDContext,
+	FeedTypeMicrosoftGraphAlert,
+	FeedTypeCrowdStrikeFalconDetections,
+	FeedTypeCrowdStrikeFalconAlerts,
+	FeedTypeSentinelOneAlerts,
+	FeedTypeSentinelOneActivity,
+	FeedTypeMicrosoftDefenderForEndpoint,
+	FeedTypeSalesforce,
+	FeedTypeDuoAdmin,
+	FeedTypeSlackAudit,
+	FeedTypeBox,
+	FeedTypeWorkday,
+	FeedTypeGitHubAudit,
+	FeedTypeCloudflareAudit,
+	FeedTypeZscaler,
+	FeedTypeNetskope,
+	FeedTypePaloAltoCortexDataLake,
+	FeedTypeMimecast,
+}
+
+// lookupRegisteredFeedType returns the registered feed type of a feed. Feed types without a log type match any log type.
+func lookupRegisteredFeedType(feedSourceType, logType string) (FeedType, bool) {
+	for _, feedType := range registeredFeedTypes {
+		if feedType.SourceType == feedSourceType && (feedType.LogType == "" || feedType.LogType == logType) {
+			return feedType, true
+		}
+	}
+
+	return FeedType{}, false
+}
+
+// RegisteredFeedConfiguration holds the settings of a registered feed type, structured as in the API,
+// e.g. {"authentication": {"user": "..."}}. Configured holds the values the settings were derived from, e.g. a service
+// account key file, when they cannot be found back in the settings. Only settings are sent.
+type RegisteredFeedConfiguration struct {
+	FeedType
+	Settings   map[string]interface{}
+	Configured map[string]interface{}
+}
+
+func (r *RegisteredFeedConfiguration) getConfigurationPropertyKey() string {
+	return r.PropertyKey
+}
+
+func (r *RegisteredFeedConfiguration) getFeedSourceType() string {
+	return r.SourceType
+}
+
+func (r *RegisteredFeedConfiguration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.Settings)
+}
+
+func (r *RegisteredFeedConfiguration) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &r.Settings)
+}
@@ -0,0 +1,16 @@
+package client
+
+const (
+	webhookFeedConfigurationPropertyKey = "httpsPushWebhookSettings"
+)
+
+type WebhookFeedConfiguration struct {
+	SplitDelimiter string `json:"splitDelimiter,omitempty"`
+}
+
+func (c *WebhookFeedConfiguration) getConfigurationPropertyKey() string {
+	return webhookFeedConfigurationPropertyKey
+}
+func (c *WebhookFeedConfiguration) getFeedSourceType() string {
+	return FeedSourceTypeWebhook
+}
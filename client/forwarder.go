@@ -0,0 +1,198 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type Forwarder struct {
+	Name        string          `json:"name,omitempty"`
+	DisplayName string          `json:"displayName,omitempty"`
+	Config      ForwarderConfig `json:"config,omitempty"`
+	State       string          `json:"state,omitempty"`
+}
+
+type ForwarderConfig struct {
+	UploadCompression bool                     `json:"uploadCompression,omitempty"`
+	Metadata          *ForwarderMetadata       `json:"metadata,omitempty"`
+	RegexFilters      []ForwarderRegexFilter   `json:"regexFilters,omitempty"`
+	ServerSettings    *ForwarderServerSettings `json:"serverSettings,omitempty"`
+}
+
+type ForwarderMetadata struct {
+	AssetNamespace string  `json:"assetNamespace,omitempty"`
+	Labels         []Label `json:"labels,omitempty"`
+}
+
+type ForwarderRegexFilter struct {
+	Description string `json:"description,omitempty"`
+	Regexp      string `json:"regexp,omitempty"`
+	Behavior    string `json:"behavior,omitempty"`
+}
+
+type ForwarderServerSettings struct {
+	State           string                 `json:"state,omitempty"`
+	GracefulTimeout int                    `json:"gracefulTimeout,omitempty"`
+	DrainTimeout    int                    `json:"drainTimeout,omitempty"`
+	HTTPSettings    *ForwarderHTTPSettings `json:"httpSettings,omitempty"`
+}
+
+type ForwarderHTTPSettings struct {
+	Port              int    `json:"port,omitempty"`
+	Host              string `json:"host,omitempty"`
+	ReadTimeout       int    `json:"readTimeout,omitempty"`
+	ReadHeaderTimeout int    `json:"readHeaderTimeout,omitempty"`
+	WriteTimeout      int    `json:"writeTimeout,omitempty"`
+	IdleTimeout       int    `json:"idleTimeout,omitempty"`
+}
+
+// ForwarderFiles are the configuration and authentication files to be deployed along with a forwarder.
+type ForwarderFiles struct {
+	Config string `json:"config"`
+	Auth   string `json:"auth"`
+}
+
+type forwarderList struct {
+	Forwarders    []Forwarder `json:"forwarders,omitempty"`
+	NextPageToken string      `json:"nextPageToken,omitempty"`
+}
+
+func (cli *Client) CreateForwarder(forwarder Forwarder) (string, error) {
+	url := cli.ForwarderBasePath
+
+	err := cli.rateLimiters.ForwarderCreateForwarder.Wait(context.Background())
+	if err != nil {
+		return "", errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while creating forwarder %s", forwarder.DisplayName))
+	}
+
+	res, err := sendRequest(cli, cli.forwarderAPIClient, "POST", cli.userAgent, url, forwarder)
+	if err != nil {
+		return "", errors.Wrap(err, "failed creating forwarder")
+	}
+
+	var forwarderRes Forwarder
+	err = json.Unmarshal(res, &forwarderRes)
+	if err != nil {
+		return "", errors.Wrap(err, "could not unmarshal forwarder response")
+	}
+
+	return lastPathSegment(forwarderRes.Name), nil
+}
+
+func (cli *Client) GetForwarder(id string) (*Forwarder, error) {
+	url := fmt.Sprintf("%s/%s", cli.ForwarderBasePath, id)
+
+	err := cli.rateLimiters.ForwarderGetForwarder.Wait(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while getting forwarder %s", id))
+	}
+
+	res, err := sendRequest(cli, cli.forwarderAPIClient, "GET", cli.userAgent, url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting forwarder")
+	}
+
+	var forwarder Forwarder
+	err = json.Unmarshal(res, &forwarder)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal forwarder response")
+	}
+	forwarder.Name = lastPathSegment(forwarder.Name)
+
+	return &forwarder, nil
+}
+
+func (cli *Client) ListForwarders() ([]Forwarder, error) {
+	forwarders := make([]Forwarder, 0)
+
+	pageToken := ""
+	for {
+		url := withPageToken(cli.ForwarderBasePath, pageToken)
+
+		err := cli.rateLimiters.ForwarderListForwarders.Wait(context.Background())
+		if err != nil {
+			return nil, errors.Wrap(err, "Error waiting for rateLimiter while listing forwarders")
+		}
+
+		res, err := sendRequest(cli, cli.forwarderAPIClient, "GET", cli.userAgent, url, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed listing forwarders")
+		}
+
+		var page forwarderList
+		err = json.Unmarshal(res, &page)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal forwarders response")
+		}
+
+		for _, forwarder := range page.Forwarders {
+			forwarder.Name = lastPathSegment(forwarder.Name)
+			forwarders = append(forwarders, forwarder)
+		}
+
+		if page.NextPageToken == "" {
+			return forwarders, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+// UpdateForwarder updates the fields of a forwarder listed in updateMask, e.g. display_name or config.regex_filters.
+func (cli *Client) UpdateForwarder(id string, forwarder Forwarder, updateMask []string) error {
+	url := fmt.Sprintf("%s/%s?update_mask=%s", cli.ForwarderBasePath, id, strings.Join(updateMask, ","))
+
+	err := cli.rateLimiters.ForwarderUpdateForwarder.Wait(context.Background())
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while updating forwarder %s", id))
+	}
+
+	_, err = sendRequest(cli, cli.forwarderAPIClient, "PATCH", cli.userAgent, url, forwarder)
+	if err != nil {
+		return errors.Wrap(err, "failed updating forwarder")
+	}
+
+	return nil
+}
+
+func (cli *Client) DeleteForwarder(id string) error {
+	url := fmt.Sprintf("%s/%s", cli.ForwarderBasePath, id)
+
+	err := cli.rateLimiters.ForwarderDeleteForwarder.Wait(context.Background())
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while deleting forwarder %s", id))
+	}
+
+	_, err = sendRequest(cli, cli.forwarderAPIClient, "DELETE", cli.userAgent, url, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed deleting forwarder")
+	}
+
+	return nil
+}
+
+// GenerateForwarderFiles renders the configuration and authentication files of a forwarder and its collectors.
+func (cli *Client) GenerateForwarderFiles(id string) (*ForwarderFiles, error) {
+	url := fmt.Sprintf("%s/%s:generateForwarderFiles", cli.ForwarderBasePath, id)
+
+	err := cli.rateLimiters.ForwarderGenerateForwarderFiles.Wait(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while generating files of forwarder %s", id))
+	}
+
+	res, err := sendRequest(cli, cli.forwarderAPIClient, "POST", cli.userAgent, url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed generating forwarder files")
+	}
+
+	var files ForwarderFiles
+	err = json.Unmarshal(res, &files)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal forwarder files response")
+	}
+
+	return &files, nil
+}
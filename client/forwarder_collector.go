@@ -0,0 +1,186 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type Collector struct {
+	Name        string          `json:"name,omitempty"`
+	DisplayName string          `json:"displayName,omitempty"`
+	Config      CollectorConfig `json:"config,omitempty"`
+	State       string          `json:"state,omitempty"`
+}
+
+type CollectorConfig struct {
+	LogType            string                   `json:"logType,omitempty"`
+	Metadata           *ForwarderMetadata       `json:"metadata,omitempty"`
+	RegexFilters       []ForwarderRegexFilter   `json:"regexFilters,omitempty"`
+	DiskBuffer         *CollectorDiskBuffer     `json:"diskBuffer,omitempty"`
+	MaxSecondsPerBatch int                      `json:"maxSecondsPerBatch,omitempty"`
+	MaxBytesPerBatch   int64                    `json:"maxBytesPerBatch,omitempty,string"`
+	FileSettings       *CollectorFileSettings   `json:"fileSettings,omitempty"`
+	SyslogSettings     *CollectorSyslogSettings `json:"syslogSettings,omitempty"`
+	SplunkSettings     *CollectorSplunkSettings `json:"splunkSettings,omitempty"`
+	PcapSettings       *CollectorPcapSettings   `json:"pcapSettings,omitempty"`
+}
+
+type CollectorDiskBuffer struct {
+	State              string `json:"state,omitempty"`
+	DirectoryPath      string `json:"directoryPath,omitempty"`
+	MaxFileBufferBytes int64  `json:"maxFileBufferBytes,omitempty,string"`
+}
+
+type CollectorFileSettings struct {
+	FilePath string `json:"filePath,omitempty"`
+}
+
+type CollectorSyslogSettings struct {
+	Protocol          string `json:"protocol,omitempty"`
+	Address           string `json:"address,omitempty"`
+	Port              int    `json:"port,omitempty"`
+	BufferSize        int64  `json:"bufferSize,omitempty,string"`
+	ConnectionTimeout int    `json:"connectionTimeout,omitempty"`
+	Certificate       string `json:"certificate,omitempty"`
+	CertificateKey    string `json:"certificateKey,omitempty"`
+	MinimumTLSVersion string `json:"minimumTlsVersion,omitempty"`
+}
+
+type CollectorSplunkSettings struct {
+	Host              string `json:"host,omitempty"`
+	Port              int    `json:"port,omitempty"`
+	Username          string `json:"username,omitempty"`
+	Password          string `json:"password,omitempty"`
+	MinimumWindowSize int    `json:"minimumWindowSize,omitempty"`
+	MaximumWindowSize int    `json:"maximumWindowSize,omitempty"`
+	QueryString       string `json:"queryString,omitempty"`
+	QueryMode         string `json:"queryMode,omitempty"`
+	CertIgnored       bool   `json:"certIgnored,omitempty"`
+}
+
+type CollectorPcapSettings struct {
+	NetworkInterface string `json:"networkInterface,omitempty"`
+	BPF              string `json:"bpf,omitempty"`
+}
+
+type collectorList struct {
+	Collectors    []Collector `json:"collectors,omitempty"`
+	NextPageToken string      `json:"nextPageToken,omitempty"`
+}
+
+func (cli *Client) CreateCollector(forwarderID string, collector Collector) (string, error) {
+	url := fmt.Sprintf("%s/%s/collectors", cli.ForwarderBasePath, forwarderID)
+
+	err := cli.rateLimiters.ForwarderCreateCollector.Wait(context.Background())
+	if err != nil {
+		return "", errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while creating collector %s", collector.DisplayName))
+	}
+
+	res, err := sendRequest(cli, cli.forwarderAPIClient, "POST", cli.userAgent, url, collector)
+	if err != nil {
+		return "", errors.Wrap(err, "failed creating collector")
+	}
+
+	var collectorRes Collector
+	err = json.Unmarshal(res, &collectorRes)
+	if err != nil {
+		return "", errors.Wrap(err, "could not unmarshal collector response")
+	}
+
+	return lastPathSegment(collectorRes.Name), nil
+}
+
+func (cli *Client) GetCollector(forwarderID, id string) (*Collector, error) {
+	url := fmt.Sprintf("%s/%s/collectors/%s", cli.ForwarderBasePath, forwarderID, id)
+
+	err := cli.rateLimiters.ForwarderGetCollector.Wait(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while getting collector %s", id))
+	}
+
+	res, err := sendRequest(cli, cli.forwarderAPIClient, "GET", cli.userAgent, url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting collector")
+	}
+
+	var collector Collector
+	err = json.Unmarshal(res, &collector)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal collector response")
+	}
+	collector.Name = lastPathSegment(collector.Name)
+
+	return &collector, nil
+}
+
+func (cli *Client) ListCollectors(forwarderID string) ([]Collector, error) {
+	collectors := make([]Collector, 0)
+
+	pageToken := ""
+	for {
+		url := withPageToken(fmt.Sprintf("%s/%s/collectors", cli.ForwarderBasePath, forwarderID), pageToken)
+
+		err := cli.rateLimiters.ForwarderListCollectors.Wait(context.Background())
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while listing collectors of forwarder %s", forwarderID))
+		}
+
+		res, err := sendRequest(cli, cli.forwarderAPIClient, "GET", cli.userAgent, url, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed listing collectors")
+		}
+
+		var page collectorList
+		err = json.Unmarshal(res, &page)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal collectors response")
+		}
+
+		for _, collector := range page.Collectors {
+			collector.Name = lastPathSegment(collector.Name)
+			collectors = append(collectors, collector)
+		}
+
+		if page.NextPageToken == "" {
+			return collectors, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+// UpdateCollector updates the fields of a collector listed in updateMask, e.g. display_name or config.syslog_settings.
+func (cli *Client) UpdateCollector(forwarderID, id string, collector Collector, updateMask []string) error {
+	url := fmt.Sprintf("%s/%s/collectors/%s?update_mask=%s", cli.ForwarderBasePath, forwarderID, id, strings.Join(updateMask, ","))
+
+	err := cli.rateLimiters.ForwarderUpdateCollector.Wait(context.Background())
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while updating collector %s", id))
+	}
+
+	_, err = sendRequest(cli, cli.forwarderAPIClient, "PATCH", cli.userAgent, url, collector)
+	if err != nil {
+		return errors.Wrap(err, "failed updating collector")
+	}
+
+	return nil
+}
+
+func (cli *Client) DeleteCollector(forwarderID, id string) error {
+	url := fmt.Sprintf("%s/%s/collectors/%s", cli.ForwarderBasePath, forwarderID, id)
+
+	err := cli.rateLimiters.ForwarderDeleteCollector.Wait(context.Background())
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while deleting collector %s", id))
+	}
+
+	_, err = sendRequest(cli, cli.forwarderAPIClient, "DELETE", cli.userAgent, url, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed deleting collector")
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+)
+
+// IngestionMaxRequestBytes is the size limit of a single Ingestion API request, larger inputs are split into batches.
+const IngestionMaxRequestBytes = 1000000
+
+type UnstructuredLogEntry struct {
+	LogText             string `json:"log_text"`
+	TsEpochMicroseconds int64  `json:"ts_epoch_microseconds,omitempty"`
+	TsRFC3339           string `json:"ts_rfc3339,omitempty"`
+}
+
+type unstructuredLogEntriesRequest struct {
+	CustomerID string            `json:"customer_id"`
+	LogType    string            `json:"log_type"`
+	Entries    []json.RawMessage `json:"entries"`
+}
+
+type udmEventsRequest struct {
+	CustomerID string            `json:"customer_id"`
+	Events     []json.RawMessage `json:"events"`
+}
+
+type entitiesRequest struct {
+	CustomerID string            `json:"customer_id"`
+	LogType    string            `json:"log_type"`
+	Entities   []json.RawMessage `json:"entities"`
+}
+
+// IngestionBatchError is returned when a batch is still rejected after every request attempt.
+// The first Ingested items were accepted, so the caller may resume from there.
+type IngestionBatchError struct {
+	Batch    int
+	Ingested int
+	Err      error
+}
+
+func (e *IngestionBatchError) Error() string {
+	return fmt.Sprintf("failed ingesting batch %d, %d items were ingested before it: %s", e.Batch, e.Ingested, e.Err)
+}
+
+func (e *IngestionBatchError) Unwrap() error {
+	return e.Err
+}
+
+// CreateUnstructuredLogEntries ingests raw log lines of logType, which are parsed by Chronicle.
+func (cli *Client) CreateUnstructuredLogEntries(logType string, entries []UnstructuredLogEntry) error {
+	items, err := marshalIngestionItems(entries)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/unstructuredlogentries:batchCreate", cli.IngestionBasePath)
+
+	return cli.ingestBatches(cli.rateLimiters.IngestionCreateUnstructuredLogEntries, url, items, func(batch []json.RawMessage) interface{} {
+		return unstructuredLogEntriesRequest{CustomerID: cli.customerID, LogType: logType, Entries: batch}
+	})
+}
+
+// CreateUDMEvents ingests events already in the Unified Data Model format, each one a JSON encoded UDM event.
+func (cli *Client) CreateUDMEvents(events []json.RawMessage) error {
+	url := fmt.Sprintf("%s/udmevents:batchCreate", cli.IngestionBasePath)
+
+	return cli.ingestBatches(cli.rateLimiters.IngestionCreateUDMEvents, url, events, func(batch []json.RawMessage) interface{} {
+		return udmEventsRequest{CustomerID: cli.customerID, Events: batch}
+	})
+}
+
+// CreateEntities ingests context entities of logType, each one a JSON encoded UDM entity.
+func (cli *Client) CreateEntities(logType string, entities []json.RawMessage) error {
+	url := fmt.Sprintf("%s/entities:batchCreate", cli.IngestionBasePath)
+
+	return cli.ingestBatches(cli.rateLimiters.IngestionCreateEntities, url, entities, func(batch []json.RawMessage) interface{} {
+		return entitiesRequest{CustomerID: cli.customerID, LogType: logType, Entities: batch}
+	})
+}
+
+// ingestBatches sends items in as few requests under IngestionMaxRequestBytes as possible.
+// Each request is retried on its own, so a failure does not resend the batches already accepted.
+func (cli *Client) ingestBatches(limiter *rate.Limiter, url string, items []json.RawMessage, newRequest func([]json.RawMessage) interface{}) error {
+	if cli.customerID == "" {
+		return fmt.Errorf("a customer ID is required to use the Ingestion API")
+	}
+
+	envelopeBytes, err := encodedRequestLength(newRequest([]json.RawMessage{}))
+	if err != nil {
+		return errors.Wrap(err, "could not marshal ingestion request")
+	}
+
+	batches, err := splitIngestionBatches(items, envelopeBytes)
+	if err != nil {
+		return err
+	}
+
+	ingested := 0
+	for i, batch := range batches {
+		err := limiter.Wait(context.Background())
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while ingesting batch %d", i))
+		}
+
+		_, err = sendRequest(cli, cli.ingestionAPIClient, "POST", cli.userAgent, url, newRequest(batch))
+		if err != nil {
+			return &IngestionBatchError{Batch: i, Ingested: ingested, Err: err}
+		}
+
+		ingested += len(batch)
+	}
+
+	return nil
+}
+
+// splitIngestionBatches groups items so that each batch, once wrapped in a request of envelopeBytes, stays under the size limit.
+// Items are measured as encoded in the request rather than as given.
+func splitIngestionBatches(items []json.RawMessage, envelopeBytes int) ([][]json.RawMessage, error) {
+	batches := make([][]json.RawMessage, 0)
+
+	var batch []json.RawMessage
+	size := envelopeBytes
+	for i, item := range items {
+		encodedBytes, err := encodedRequestLength(item)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("could not marshal ingestion item %d", i))
+		}
+
+		// Items are separated by a comma in the encoded request, instead of the newline ending an encoded value.
+		itemBytes := encodedBytes
+		if envelopeBytes+itemBytes > IngestionMaxRequestBytes {
+			return nil, fmt.Errorf("item %d is %d bytes once encoded, which exceeds the %d bytes request limit", i, encodedBytes-1, IngestionMaxRequestBytes)
+		}
+
+		if size+itemBytes > IngestionMaxRequestBytes {
+			batches = append(batches, batch)
+			batch = nil
+			size = envelopeBytes
+		}
+
+		batch = append(batch, item)
+		size += itemBytes
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches, nil
+}
+
+// encodedRequestLength returns the length of v once encoded as a request body by sendRequest, which compacts raw JSON
+// values and escapes HTML characters, e.g. < becomes \u003c.
+func encodedRequestLength(v interface{}) (int, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		return 0, err
+	}
+
+	return buf.Len(), nil
+}
+
+func marshalIngestionItems[T any](items []T) ([]json.RawMessage, error) {
+	result := make([]json.RawMessage, 0, len(items))
+	for _, item := range items {
+		raw, err := json.Marshal(item)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not marshal ingestion item")
+		}
+		result = append(result, raw)
+	}
+
+	return result, nil
+}
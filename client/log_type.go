@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/pkg/errors"
+)
+
+type LogType struct {
+	LogType     string `json:"logType"`
+	DisplayName string `json:"label"`
+}
+
+type logTypeList struct {
+	LogTypes []LogType `json:"logTypes,omitempty"`
+}
+
+type instanceLogType struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type instanceLogTypeList struct {
+	LogTypes      []instanceLogType `json:"logTypes,omitempty"`
+	NextPageToken string            `json:"nextPageToken,omitempty"`
+}
+
+// ListLogTypes returns the log types supported by Chronicle. The catalogue is fetched once per client.
+func (cli *Client) ListLogTypes() ([]LogType, error) {
+	cli.logTypesMutex.Lock()
+	defer cli.logTypesMutex.Unlock()
+
+	if cli.logTypes != nil {
+		return cli.logTypes, nil
+	}
+
+	var logTypes []LogType
+	var err error
+	if cli.usesInstanceAPI() {
+		logTypes, err = cli.listInstanceLogTypes()
+	} else {
+		logTypes, err = cli.listLegacyLogTypes()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cli.logTypes = logTypes
+
+	return logTypes, nil
+}
+
+// ValidateLogType returns an error if logType is not supported by Chronicle.
+// It is a no-op unless the client was created with WithLogTypeValidation, and log types are not validated
+// when the catalogue cannot be listed, e.g. when the credentials are not allowed to.
+func (cli *Client) ValidateLogType(logType string) error {
+	if !cli.validateLogTypes {
+		return nil
+	}
+
+	logTypes, err := cli.ListLogTypes()
+	if err != nil {
+		log.Printf("[WARN] Not validating log type %s, as log types could not be listed: %s", logType, err)
+		return nil
+	}
+
+	for _, l := range logTypes {
+		if l.LogType == logType {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("log type %s is not supported by Chronicle", logType)
+}
+
+func (cli *Client) listLegacyLogTypes() ([]LogType, error) {
+	err := cli.rateLimiters.IngestionListLogTypes.Wait(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, "Error waiting for rateLimiter while listing log types")
+	}
+
+	res, err := sendRequest(cli, cli.backstoryAPIClient, "GET", cli.userAgent, cli.LogTypesBasePath, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed listing log types")
+	}
+
+	var list logTypeList
+	err = json.Unmarshal(res, &list)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal log types response")
+	}
+
+	return list.LogTypes, nil
+}
+
+func (cli *Client) listInstanceLogTypes() ([]LogType, error) {
+	logTypes := make([]LogType, 0)
+
+	pageToken := ""
+	for {
+		url := withPageToken(cli.LogTypesBasePath, pageToken)
+
+		err := cli.rateLimiters.IngestionListLogTypes.Wait(context.Background())
+		if err != nil {
+			return nil, errors.Wrap(err, "Error waiting for rateLimiter while listing log types")
+		}
+
+		res, err := sendRequest(cli, cli.backstoryAPIClient, "GET", cli.userAgent, url, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed listing log types")
+		}
+
+		var page instanceLogTypeList
+		err = json.Unmarshal(res, &page)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal log types response")
+		}
+
+		for _, logType := range page.LogTypes {
+			logTypes = append(logTypes, LogType{LogType: lastPathSegment(logType.Name), DisplayName: logType.DisplayName})
+		}
+
+		if page.NextPageToken == "" {
+			return logTypes, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
@@ -31,6 +31,10 @@ const ReferenceListContentTypeREGEX ReferenceListContentType = "REGEX"
 const ReferenceListContentTypeCIDR ReferenceListContentType = "CIDR"
 
 func (cli *Client) GetReferenceList(name string) (*ReferenceList, error) {
+	if cli.usesInstanceAPI() {
+		return cli.getInstanceReferenceList(name)
+	}
+
 	url := fmt.Sprintf("%s/%s", cli.ReferenceListsBasePath, name)
 
 	err := cli.rateLimiters.ReferenceListsGetList.Wait(context.Background())
@@ -74,6 +78,10 @@ func (cli *Client) GetReferenceList(name string) (*ReferenceList, error) {
 }
 
 func (cli *Client) CreateReferenceList(referenceList ReferenceList) (string, error) {
+	if cli.usesInstanceAPI() {
+		return cli.createInstanceReferenceList(referenceList)
+	}
+
 	url := cli.ReferenceListsBasePath
 
 	err := cli.rateLimiters.ReferenceListsCreateList.Wait(context.Background())
@@ -96,6 +104,10 @@ func (cli *Client) CreateReferenceList(referenceList ReferenceList) (string, err
 }
 
 func (cli *Client) UpdateReferenceList(referenceList ReferenceList, updateLines, updateDescription bool) (*ReferenceList, error) {
+	if cli.usesInstanceAPI() {
+		return cli.updateInstanceReferenceList(referenceList, updateLines, updateDescription)
+	}
+
 	url := fmt.Sprintf("%s?update_mask=%s", cli.ReferenceListsBasePath, CreateReferenceListUpdateMask(updateLines, updateDescription))
 
 	err := cli.rateLimiters.ReferenceListsUpdateList.Wait(context.Background())
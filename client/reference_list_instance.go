@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Syntax types of reference lists in the instance-scoped Chronicle API.
+const (
+	instanceReferenceListSyntaxTypeString = "REFERENCE_LIST_SYNTAX_TYPE_PLAIN_TEXT_STRING"
+	instanceReferenceListSyntaxTypeREGEX  = "REFERENCE_LIST_SYNTAX_TYPE_REGEX"
+	instanceReferenceListSyntaxTypeCIDR   = "REFERENCE_LIST_SYNTAX_TYPE_CIDR"
+)
+
+var instanceReferenceListSyntaxTypes = map[ReferenceListContentType]string{
+	ReferenceListContentTypeDefault: instanceReferenceListSyntaxTypeString,
+	ReferenceListContentTypeREGEX:   instanceReferenceListSyntaxTypeREGEX,
+	ReferenceListContentTypeCIDR:    instanceReferenceListSyntaxTypeCIDR,
+}
+
+// instanceReferenceList is a reference list as handled by the instance-scoped Chronicle API.
+type instanceReferenceList struct {
+	Name               string                       `json:"name,omitempty"`
+	DisplayName        string                       `json:"displayName,omitempty"`
+	Description        string                       `json:"description,omitempty"`
+	Entries            []instanceReferenceListEntry `json:"entries,omitempty"`
+	SyntaxType         string                       `json:"syntaxType,omitempty"`
+	RevisionCreateTime string                       `json:"revisionCreateTime,omitempty"`
+}
+
+type instanceReferenceListEntry struct {
+	Value string `json:"value"`
+}
+
+func newInstanceReferenceList(referenceList ReferenceList) instanceReferenceList {
+	entries := make([]instanceReferenceListEntry, 0, len(referenceList.Lines))
+	for _, line := range referenceList.Lines {
+		entries = append(entries, instanceReferenceListEntry{Value: line})
+	}
+
+	return instanceReferenceList{
+		Description: referenceList.Description,
+		Entries:     entries,
+		SyntaxType:  instanceReferenceListSyntaxTypes[referenceList.ContentType],
+	}
+}
+
+func (l *instanceReferenceList) toReferenceList() *ReferenceList {
+	lines := make([]string, 0, len(l.Entries))
+	for _, entry := range l.Entries {
+		lines = append(lines, entry.Value)
+	}
+
+	contentType := ReferenceListContentTypeDefault
+	for legacyContentType, syntaxType := range instanceReferenceListSyntaxTypes {
+		if syntaxType == l.SyntaxType {
+			contentType = legacyContentType
+		}
+	}
+
+	return &ReferenceList{
+		Name:        lastPathSegment(l.Name),
+		Description: l.Description,
+		Lines:       lines,
+		ContentType: contentType,
+		CreateTime:  l.RevisionCreateTime,
+	}
+}
+
+func (cli *Client) getInstanceReferenceList(name string) (*ReferenceList, error) {
+	url := fmt.Sprintf("%s/%s", cli.ReferenceListsBasePath, name)
+
+	err := cli.rateLimiters.ReferenceListsGetList.Wait(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while getting reference list %s", name))
+	}
+
+	res, err := sendRequest(cli, cli.backstoryAPIClient, "GET", cli.userAgent, url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting reference list")
+	}
+
+	var referenceList instanceReferenceList
+	err = json.Unmarshal(res, &referenceList)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal reference list response")
+	}
+
+	return referenceList.toReferenceList(), nil
+}
+
+func (cli *Client) createInstanceReferenceList(referenceList ReferenceList) (string, error) {
+	url := fmt.Sprintf("%s?referenceListId=%s", cli.ReferenceListsBasePath, referenceList.Name)
+
+	err := cli.rateLimiters.ReferenceListsCreateList.Wait(context.Background())
+	if err != nil {
+		return "", errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while creating reference list %v", referenceList))
+	}
+
+	res, err := sendRequest(cli, cli.backstoryAPIClient, "POST", cli.userAgent, url, newInstanceReferenceList(referenceList))
+	if err != nil {
+		return "", errors.Wrap(err, "failed creating reference list")
+	}
+
+	var referenceListRes instanceReferenceList
+	err = json.Unmarshal(res, &referenceListRes)
+	if err != nil {
+		return "", errors.Wrap(err, "could not unmarshal reference list response")
+	}
+
+	return lastPathSegment(referenceListRes.Name), nil
+}
+
+func (cli *Client) updateInstanceReferenceList(referenceList ReferenceList, updateLines, updateDescription bool) (*ReferenceList, error) {
+	mask := make([]string, 0)
+	if updateLines {
+		mask = append(mask, "entries")
+	}
+	if updateDescription {
+		mask = append(mask, "description")
+	}
+	url := fmt.Sprintf("%s/%s?update_mask=%s", cli.ReferenceListsBasePath, referenceList.Name, strings.Join(mask, ","))
+
+	err := cli.rateLimiters.ReferenceListsUpdateList.Wait(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while updating reference list %v", referenceList))
+	}
+
+	res, err := sendRequest(cli, cli.backstoryAPIClient, "PATCH", cli.userAgent, url, newInstanceReferenceList(referenceList))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed updating reference list")
+	}
+
+	var referenceListRes instanceReferenceList
+	err = json.Unmarshal(res, &referenceListRes)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal reference list response")
+	}
+
+	return referenceListRes.toReferenceList(), nil
+}
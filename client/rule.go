@@ -28,6 +28,10 @@ type YARALValidation struct {
 }
 
 func (cli *Client) GetRule(id string) (*Rule, error) {
+	if cli.usesInstanceAPI() {
+		return cli.getInstanceRule(id)
+	}
+
 	url := fmt.Sprintf("%s/%s", cli.RuleBasePath, id)
 
 	err := cli.rateLimiters.DetectionGetRule.Wait(context.Background())
@@ -50,6 +54,10 @@ func (cli *Client) GetRule(id string) (*Rule, error) {
 }
 
 func (cli *Client) CreateRule(rule Rule) (string, error) {
+	if cli.usesInstanceAPI() {
+		return cli.createInstanceRule(rule)
+	}
+
 	url := cli.RuleBasePath
 
 	err := cli.rateLimiters.DetectionCreateRule.Wait(context.Background())
@@ -72,6 +80,10 @@ func (cli *Client) CreateRule(rule Rule) (string, error) {
 }
 
 func (cli *Client) CreateRuleVersion(rule Rule) error {
+	if cli.usesInstanceAPI() {
+		return cli.createInstanceRuleVersion(rule)
+	}
+
 	url := fmt.Sprintf("%s/%s:createVersion", cli.RuleBasePath, rule.ID)
 
 	err := cli.rateLimiters.DetectionCreateRuleVersion.Wait(context.Background())
@@ -101,6 +113,10 @@ func (cli *Client) ChangeAlertingRule(id string, alertingEnabled bool) error {
 		return errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while change alerting enabled on rule %s", id))
 	}
 
+	if cli.usesInstanceAPI() {
+		return cli.updateInstanceRuleDeployment(id, "alerting", instanceRuleDeployment{Alerting: alertingEnabled})
+	}
+
 	_, err = sendRequest(cli, cli.backstoryAPIClient, "POST", cli.userAgent, url, nil)
 	if err != nil {
 		return errors.Wrap(err, "failed changing alerting in rule")
@@ -123,6 +139,10 @@ func (cli *Client) ChangeLiveRule(id string, liveEnabled bool) error {
 		return errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while change live enabled on rule %s", id))
 	}
 
+	if cli.usesInstanceAPI() {
+		return cli.updateInstanceRuleDeployment(id, "enabled", instanceRuleDeployment{Enabled: liveEnabled})
+	}
+
 	_, err = sendRequest(cli, cli.backstoryAPIClient, "POST", cli.userAgent, url, nil)
 	if err != nil {
 		return errors.Wrap(err, "failed changing live in rule")
@@ -148,6 +168,10 @@ func (cli *Client) DeleteRule(id string) error {
 }
 
 func (cli *Client) VerifyYARARule(yaraRule string) (bool, error) {
+	if cli.usesInstanceAPI() {
+		return cli.verifyInstanceRuleText(yaraRule)
+	}
+
 	url := fmt.Sprintf("%s:verifyRule", cli.RuleBasePath)
 	body := map[string]string{
 		"ruleText": yaraRule,
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// instanceRule is a rule as returned by the instance-scoped Chronicle API.
+type instanceRule struct {
+	Name                   string                   `json:"name,omitempty"`
+	RevisionID             string                   `json:"revisionId,omitempty"`
+	DisplayName            string                   `json:"displayName,omitempty"`
+	Text                   string                   `json:"text,omitempty"`
+	Metadata               map[string]string        `json:"metadata,omitempty"`
+	Type                   string                   `json:"type,omitempty"`
+	RevisionCreateTime     string                   `json:"revisionCreateTime,omitempty"`
+	CompilationState       string                   `json:"compilationState,omitempty"`
+	CompilationDiagnostics []instanceRuleDiagnostic `json:"compilationDiagnostics,omitempty"`
+	Deployment             *instanceRuleDeployment  `json:"-"`
+}
+
+type instanceRuleDiagnostic struct {
+	Message  string `json:"message,omitempty"`
+	Severity string `json:"severity,omitempty"`
+}
+
+type instanceRuleDeployment struct {
+	Enabled  bool `json:"enabled"`
+	Alerting bool `json:"alerting"`
+}
+
+type instanceRuleTextValidation struct {
+	Success                bool                     `json:"success"`
+	CompilationDiagnostics []instanceRuleDiagnostic `json:"compilationDiagnostics,omitempty"`
+}
+
+func (r *instanceRule) toRule() *Rule {
+	id := lastPathSegment(r.Name)
+	rule := &Rule{
+		Text:              r.Text,
+		ID:                id,
+		Name:              r.DisplayName,
+		Metadata:          r.Metadata,
+		Type:              r.Type,
+		VersionCreateTime: r.RevisionCreateTime,
+		CompilationState:  r.CompilationState,
+		CompilationError:  compilationDiagnosticsMessage(r.CompilationDiagnostics),
+	}
+	if r.RevisionID != "" {
+		rule.VersionID = fmt.Sprintf("%s@%s", id, r.RevisionID)
+	}
+	if r.Deployment != nil {
+		rule.LiveEnabled = r.Deployment.Enabled
+		rule.AlertingEnabled = r.Deployment.Alerting
+	}
+
+	return rule
+}
+
+func compilationDiagnosticsMessage(diagnostics []instanceRuleDiagnostic) string {
+	messages := make([]string, 0, len(diagnostics))
+	for _, diagnostic := range diagnostics {
+		messages = append(messages, diagnostic.Message)
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+func (cli *Client) getInstanceRule(id string) (*Rule, error) {
+	url := fmt.Sprintf("%s/%s", cli.RuleBasePath, id)
+
+	err := cli.rateLimiters.DetectionGetRule.Wait(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while getting rule %s", id))
+	}
+
+	res, err := sendRequest(cli, cli.backstoryAPIClient, "GET", cli.userAgent, url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting rule")
+	}
+
+	var rule instanceRule
+	err = json.Unmarshal(res, &rule)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal rule response")
+	}
+
+	res, err = sendRequest(cli, cli.backstoryAPIClient, "GET", cli.userAgent, fmt.Sprintf("%s/deployment", url), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting rule deployment")
+	}
+
+	var deployment instanceRuleDeployment
+	err = json.Unmarshal(res, &deployment)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal rule deployment response")
+	}
+	rule.Deployment = &deployment
+
+	return rule.toRule(), nil
+}
+
+func (cli *Client) createInstanceRule(rule Rule) (string, error) {
+	url := cli.RuleBasePath
+
+	err := cli.rateLimiters.DetectionCreateRule.Wait(context.Background())
+	if err != nil {
+		return "", errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while creating rule %v", rule))
+	}
+
+	res, err := sendRequest(cli, cli.backstoryAPIClient, "POST", cli.userAgent, url, instanceRule{Text: rule.Text})
+	if err != nil {
+		return "", errors.Wrap(err, "failed creating rule")
+	}
+
+	var ruleRes instanceRule
+	err = json.Unmarshal(res, &ruleRes)
+	if err != nil {
+		return "", errors.Wrap(err, "could not unmarshal rule response")
+	}
+
+	return lastPathSegment(ruleRes.Name), nil
+}
+
+// createInstanceRuleVersion updates the rule text, which creates a new revision of the rule.
+func (cli *Client) createInstanceRuleVersion(rule Rule) error {
+	url := fmt.Sprintf("%s/%s?update_mask=text", cli.RuleBasePath, rule.ID)
+
+	err := cli.rateLimiters.DetectionCreateRuleVersion.Wait(context.Background())
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while creating rule version %v", rule))
+	}
+
+	_, err = sendRequest(cli, cli.backstoryAPIClient, "PATCH", cli.userAgent, url, instanceRule{Text: rule.Text})
+	if err != nil {
+		return errors.Wrap(err, "failed creating rule")
+	}
+
+	return nil
+}
+
+func (cli *Client) updateInstanceRuleDeployment(id, field string, deployment instanceRuleDeployment) error {
+	url := fmt.Sprintf("%s/%s/deployment?update_mask=%s", cli.RuleBasePath, id, field)
+
+	_, err := sendRequest(cli, cli.backstoryAPIClient, "PATCH", cli.userAgent, url, deployment)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("failed updating %s in rule deployment", field))
+	}
+
+	return nil
+}
+
+func (cli *Client) verifyInstanceRuleText(ruleText string) (bool, error) {
+	url := fmt.Sprintf("%s:verifyRuleText", cli.InstanceBasePath)
+	body := map[string]string{
+		"ruleText": ruleText,
+	}
+
+	err := cli.rateLimiters.DetectionVerifyYARARule.Wait(context.Background())
+	if err != nil {
+		return false, errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter while verifying rule %s", ruleText))
+	}
+
+	res, err := sendRequest(cli, cli.backstoryAPIClient, "POST", cli.userAgent, url, body)
+	if err != nil {
+		return false, errors.Wrap(err, "failed verifying rule")
+	}
+
+	var validation instanceRuleTextValidation
+	err = json.Unmarshal(res, &validation)
+	if err != nil {
+		return false, errors.Wrap(err, "could not unmarshal validation response")
+	}
+
+	if validation.Success {
+		return true, nil
+	}
+
+	return false, fmt.Errorf("compilation error: %s", compilationDiagnosticsMessage(validation.CompilationDiagnostics))
+}
@@ -31,6 +31,10 @@ type Permission struct {
 }
 
 func (cli *Client) GetSubject(name string) (*Subject, error) {
+	if cli.usesInstanceAPI() {
+		return cli.getInstanceSubject(name)
+	}
+
 	url := fmt.Sprintf("%s/%s", cli.SubjectsBasePath, name)
 
 	err := cli.rateLimiters.RBACGetSubject.Wait(context.Background())
@@ -53,6 +57,10 @@ func (cli *Client) GetSubject(name string) (*Subject, error) {
 }
 
 func (cli *Client) CreateSubject(subject Subject) error {
+	if cli.usesInstanceAPI() {
+		return cli.createInstanceSubject(subject)
+	}
+
 	url := cli.SubjectsBasePath
 
 	err := cli.rateLimiters.RBACCreateSubject.Wait(context.Background())
@@ -69,6 +77,10 @@ func (cli *Client) CreateSubject(subject Subject) error {
 }
 
 func (cli *Client) UpdateSubject(subject Subject) error {
+	if cli.usesInstanceAPI() {
+		return cli.updateInstanceSubject(subject)
+	}
+
 	url := fmt.Sprintf("%s/%s", cli.SubjectsBasePath, subject.Name)
 
 	err := cli.rateLimiters.RBACUpdateSubject.Wait(context.Background())
@@ -85,6 +97,10 @@ func (cli *Client) UpdateSubject(subject Subject) error {
 }
 
 func (cli *Client) DeleteSubject(name string) error {
+	if cli.usesInstanceAPI() {
+		return cli.deleteInstanceSubject(name)
+	}
+
 	url := fmt.Sprintf("%s/%s", cli.SubjectsBasePath, name)
 
 	err := cli.rateLimiters.RBACDeleteSubject.Wait(context.Background())
@@ -0,0 +1,253 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Instances migrated to Google Cloud IAM grant access through IAM role bindings of the instance project instead of
+// subjects: the subject is the member of the bindings, and its roles the roles bound to it. Only unconditional bindings
+// of Chronicle roles are managed, so that bindings granted elsewhere are left untouched.
+
+const (
+	iamMemberUserPrefix  = "user:"
+	iamMemberGroupPrefix = "group:"
+
+	iamChronicleRolePrefix = "roles/chronicle."
+
+	subjectTypeAnalyst  = "SUBJECT_TYPE_ANALYST"
+	subjectTypeIDPGroup = "SUBJECT_TYPE_IDP_GROUP"
+)
+
+// iamRoles maps the predefined roles of the legacy RBAC API to the IAM roles replacing them.
+var iamRoles = map[string]string{
+	"Administrator":            "roles/chronicle.admin",
+	"Editor":                   "roles/chronicle.editor",
+	"Viewer":                   "roles/chronicle.viewer",
+	"ViewerWithNoDetectAccess": "roles/chronicle.limitedViewer",
+}
+
+type iamPolicy struct {
+	Version  int          `json:"version,omitempty"`
+	Bindings []iamBinding `json:"bindings,omitempty"`
+	Etag     string       `json:"etag,omitempty"`
+}
+
+type iamBinding struct {
+	Role      string          `json:"role"`
+	Members   []string        `json:"members"`
+	Condition json.RawMessage `json:"condition,omitempty"`
+}
+
+// IAMRoleName returns the IAM role granted for role, either a predefined role of the legacy RBAC API or an IAM role.
+func IAMRoleName(role string) string {
+	if iamRole, ok := iamRoles[role]; ok {
+		return iamRole
+	}
+
+	return role
+}
+
+// rbacRoleName returns the predefined role of the legacy RBAC API replaced by iamRole, if any.
+func rbacRoleName(iamRole string) string {
+	for role, r := range iamRoles {
+		if r == iamRole {
+			return role
+		}
+	}
+
+	return iamRole
+}
+
+// iamMember returns the IAM member of a subject: analysts are users and IdP groups are groups, unless the name is
+// already a member, e.g. a workforce identity federation principal set.
+func iamMember(name, subjectType string) string {
+	if strings.Contains(name, ":") {
+		return name
+	}
+	if subjectType == subjectTypeIDPGroup {
+		return iamMemberGroupPrefix + name
+	}
+
+	return iamMemberUserPrefix + name
+}
+
+func isManagedIAMBinding(binding iamBinding) bool {
+	if len(binding.Condition) > 0 && string(binding.Condition) != "null" {
+		return false
+	}
+
+	return strings.HasPrefix(binding.Role, iamChronicleRolePrefix) || rbacRoleName(binding.Role) != binding.Role
+}
+
+func (cli *Client) getInstanceSubject(name string) (*Subject, error) {
+	err := cli.rateLimiters.RBACGetSubject.Wait(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter getting subject %s", name))
+	}
+
+	policy, err := cli.getIAMPolicy()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting subject")
+	}
+
+	members := []string{iamMember(name, subjectTypeAnalyst), iamMember(name, subjectTypeIDPGroup)}
+
+	subject := &Subject{Name: name}
+	for _, binding := range policy.Bindings {
+		if !isManagedIAMBinding(binding) {
+			continue
+		}
+		for _, member := range binding.Members {
+			if !contains(members, member) {
+				continue
+			}
+
+			subject.Type = subjectTypeAnalyst
+			if strings.HasPrefix(member, iamMemberGroupPrefix) {
+				subject.Type = subjectTypeIDPGroup
+			}
+			subject.Roles = append(subject.Roles, Role{Name: rbacRoleName(binding.Role)})
+		}
+	}
+
+	if len(subject.Roles) == 0 {
+		return nil, &ChronicleAPIError{
+			Message:        fmt.Sprintf("no Chronicle role is bound to %s", name),
+			HTTPStatusCode: http.StatusNotFound,
+		}
+	}
+
+	return subject, nil
+}
+
+func (cli *Client) createInstanceSubject(subject Subject) error {
+	err := cli.rateLimiters.RBACCreateSubject.Wait(context.Background())
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter creating subject %v", subject))
+	}
+
+	if err := cli.setSubjectIAMRoles(iamMember(subject.Name, subject.Type), subject.Roles); err != nil {
+		return errors.Wrap(err, "failed creating subject")
+	}
+
+	return nil
+}
+
+func (cli *Client) updateInstanceSubject(subject Subject) error {
+	err := cli.rateLimiters.RBACUpdateSubject.Wait(context.Background())
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter updating subject %v", subject))
+	}
+
+	if err := cli.setSubjectIAMRoles(iamMember(subject.Name, subject.Type), subject.Roles); err != nil {
+		return errors.Wrap(err, "failed updating subject")
+	}
+
+	return nil
+}
+
+func (cli *Client) deleteInstanceSubject(name string) error {
+	err := cli.rateLimiters.RBACDeleteSubject.Wait(context.Background())
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("Error waiting for rateLimiter deleting subject %s", name))
+	}
+
+	for _, member := range []string{iamMember(name, subjectTypeAnalyst), iamMember(name, subjectTypeIDPGroup)} {
+		if err := cli.setSubjectIAMRoles(member, nil); err != nil {
+			return errors.Wrap(err, "failed deleting subject")
+		}
+	}
+
+	return nil
+}
+
+// setSubjectIAMRoles binds exactly roles to member among the managed bindings of the instance project.
+// Policy updates are serialized, as concurrent updates of the same policy fail on the etag.
+func (cli *Client) setSubjectIAMRoles(member string, roles []Role) error {
+	cli.iamPolicyMutex.Lock()
+	defer cli.iamPolicyMutex.Unlock()
+
+	policy, err := cli.getIAMPolicy()
+	if err != nil {
+		return err
+	}
+
+	iamRoleNames := make([]string, 0, len(roles))
+	for _, role := range roles {
+		iamRoleNames = append(iamRoleNames, IAMRoleName(role.Name))
+	}
+
+	bindings := make([]iamBinding, 0, len(policy.Bindings)+len(iamRoleNames))
+	for _, binding := range policy.Bindings {
+		if isManagedIAMBinding(binding) {
+			members := make([]string, 0, len(binding.Members))
+			for _, m := range binding.Members {
+				if m != member {
+					members = append(members, m)
+				}
+			}
+			if contains(iamRoleNames, binding.Role) {
+				members = append(members, member)
+				iamRoleNames = removeString(iamRoleNames, binding.Role)
+			}
+			if len(members) == 0 {
+				continue
+			}
+			binding.Members = members
+		}
+		bindings = append(bindings, binding)
+	}
+	sort.Strings(iamRoleNames)
+	for _, role := range iamRoleNames {
+		bindings = append(bindings, iamBinding{Role: role, Members: []string{member}})
+	}
+	policy.Bindings = bindings
+
+	url := fmt.Sprintf("%s:setIamPolicy", cli.SubjectsBasePath)
+	request := map[string]interface{}{
+		"policy":     policy,
+		"updateMask": "bindings,etag",
+	}
+	if _, err := sendRequest(cli, cli.backstoryAPIClient, "POST", cli.userAgent, url, request); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cli *Client) getIAMPolicy() (*iamPolicy, error) {
+	url := fmt.Sprintf("%s:getIamPolicy", cli.SubjectsBasePath)
+	request := map[string]interface{}{
+		"options": map[string]interface{}{"requestedPolicyVersion": 3},
+	}
+
+	res, err := sendRequest(cli, cli.backstoryAPIClient, "POST", cli.userAgent, url, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy iamPolicy
+	if err := json.Unmarshal(res, &policy); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal IAM policy response")
+	}
+
+	return &policy, nil
+}
+
+func removeString(s []string, s1 string) []string {
+	result := make([]string, 0, len(s))
+	for _, a := range s {
+		if a != s1 {
+			result = append(result, a)
+		}
+	}
+
+	return result
+}
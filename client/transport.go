@@ -13,7 +13,24 @@ import (
 	"google.golang.org/api/googleapi"
 )
 
+// unavailableCredentialsTransport is the transport of the APIs whose Application Default Credentials could not be
+// loaded, holding the reason why.
+type unavailableCredentialsTransport struct {
+	err error
+}
+
+func (t unavailableCredentialsTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, t.err
+}
+
 func sendRequest(client *Client, httpClient *http.Client, method, userAgent string, rawurl string, body interface{}) ([]byte, error) {
+	if httpClient == nil {
+		return nil, fmt.Errorf("no credentials available to request %s, configure the API credentials, access token, environment variable or Application Default Credentials", rawurl)
+	}
+	if t, ok := httpClient.Transport.(unavailableCredentialsTransport); ok {
+		return nil, fmt.Errorf("no credentials available to request %s, configure the API credentials, access token, environment variable or Application Default Credentials: %s", rawurl, t.err)
+	}
+
 	reqHeaders := make(http.Header)
 	reqHeaders.Set("Content-Type", "application/json")
 	reqHeaders.Set("User-Agent", userAgent)
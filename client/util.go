@@ -2,12 +2,38 @@ package client
 
 import (
 	"encoding/json"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/mitchellh/go-homedir"
 )
 
+// checks if a string is present in a slice.
+func contains(s []string, s1 string) bool {
+	for _, a := range s {
+		if a == s1 {
+			return true
+		}
+	}
+	return false
+}
+
+// lastPathSegment returns the ID part of a resource name such as projects/p/locations/l/instances/i/feeds/{id}.
+func lastPathSegment(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+// withPageToken returns the URL of the page of a list call following pageToken, or of the first page if it is empty.
+func withPageToken(listURL, pageToken string) string {
+	if pageToken == "" {
+		return listURL
+	}
+
+	return listURL + "?page_token=" + url.QueryEscape(pageToken)
+}
+
 func envSearch(s string) string {
 	if v := os.Getenv(s); v != "" {
 		return v
@@ -0,0 +1,7 @@
+data "chronicle_feed" "github" {
+  feed_id = "f3b4c5d6-0000-0000-0000-000000000000"
+}
+
+output "github_feed_bucket" {
+  value = jsondecode(data.chronicle_feed.github.details_json).s3Uri
+}
@@ -0,0 +1,7 @@
+data "chronicle_feed_service_account" "chronicle" {}
+
+resource "google_storage_bucket_iam_member" "chronicle" {
+  bucket = "my-bucket"
+  role   = "roles/storage.objectViewer"
+  member = data.chronicle_feed_service_account.chronicle.member
+}
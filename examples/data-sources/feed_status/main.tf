@@ -0,0 +1,7 @@
+data "chronicle_feed_status" "s3" {
+  feed_id = chronicle_feed_amazon_s3.s3.id
+}
+
+output "s3_feed_failure" {
+  value = data.chronicle_feed_status.s3.healthy ? null : data.chronicle_feed_status.s3.failure_message
+}
@@ -0,0 +1,5 @@
+data "chronicle_feeds" "all" {}
+
+output "failed_feeds" {
+  value = [for f in data.chronicle_feeds.all.feeds : f.display_name if f.state == "FAILED"]
+}
@@ -0,0 +1,13 @@
+data "chronicle_forwarder_config" "forwarder" {
+  forwarder_id = chronicle_forwarder.forwarder.id
+}
+
+resource "local_sensitive_file" "forwarder_config" {
+  content  = data.chronicle_forwarder_config.forwarder.config
+  filename = "${path.module}/forwarder.conf"
+}
+
+resource "local_sensitive_file" "forwarder_auth" {
+  content  = data.chronicle_forwarder_config.forwarder.auth
+  filename = "${path.module}/forwarder_auth.conf"
+}
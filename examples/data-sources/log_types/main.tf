@@ -0,0 +1,5 @@
+data "chronicle_log_types" "all" {}
+
+output "github_log_types" {
+  value = [for l in data.chronicle_log_types.all.log_types : l.log_type if startswith(l.log_type, "GITHUB")]
+}
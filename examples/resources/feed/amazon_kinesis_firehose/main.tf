@@ -0,0 +1,31 @@
+resource "chronicle_feed_amazon_kinesis_firehose" "firehose" {
+  display_name            = "myfirehosefeed"
+  log_type                = "AWS_CLOUDWATCH"
+  enabled                 = true
+  namespace               = "one"
+  secret_rotation_trigger = "2026-01-01"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    split_delimiter = "\n"
+  }
+}
+
+# Firehose authenticates with the API key generated for the feed, sent in the endpoint URL, and the feed secret.
+resource "aws_kinesis_firehose_delivery_stream" "chronicle" {
+  name        = "chronicle-cloudwatch"
+  destination = "http_endpoint"
+
+  http_endpoint_configuration {
+    name       = "Chronicle"
+    url        = chronicle_feed_amazon_kinesis_firehose.firehose.endpoint_url_with_api_key
+    access_key = chronicle_feed_amazon_kinesis_firehose.firehose.secret
+    role_arn   = "arn:aws:iam::123456789012:role/firehose"
+
+    s3_configuration {
+      role_arn   = "arn:aws:iam::123456789012:role/firehose"
+      bucket_arn = "arn:aws:s3:::chronicle-firehose-backup"
+    }
+  }
+}
@@ -16,5 +16,60 @@ resource "chronicle_feed_amazon_s3" "s3" {
       secret_access_key = "XXXX"
     }
   }
+  schedule {
+    interval = "6h"
+  }
+  backfill_start_time = "2024-01-01T00:00:00Z"
+}
 
+# Write-only secrets are never stored in state. Changing rotate_secrets sends them to Chronicle again.
+resource "chronicle_feed_amazon_s3" "s3_write_only" {
+  display_name = "mys3writeonlyfeed"
+  log_type     = "GITHUB"
+  enabled      = false
+  details {
+    s3_uri                = "s3://s3-bucket/"
+    s3_source_type        = "FOLDERS_RECURSIVE"
+    source_delete_options = "SOURCE_DELETION_NEVER"
+    authentication {
+      region               = "EU_WEST_1"
+      access_key_id        = "XXXXX"
+      secret_access_key_wo = "XXXX"
+    }
+  }
+  rotate_secrets = {
+    rotated_at = "2024-01-01"
+  }
+}
+
+resource "chronicle_feed_amazon_s3" "s3_role" {
+  display_name       = "mys3rolefeed"
+  log_type           = "GITHUB"
+  enabled            = true
+  wait_for_first_run = true
+  details {
+    s3_uri                = "s3://s3-bucket/"
+    s3_source_type        = "FOLDERS_RECURSIVE"
+    source_delete_options = "SOURCE_DELETION_NEVER"
+    role_authentication {
+      region   = "EU_WEST_1"
+      role_arn = "arn:aws:iam::123456789012:role/chronicle"
+    }
+  }
+}
+
+# The role trusts the Chronicle service account through identity federation.
+data "aws_iam_policy_document" "chronicle_trust" {
+  statement {
+    actions = ["sts:AssumeRoleWithWebIdentity"]
+    principals {
+      type        = "Federated"
+      identifiers = ["accounts.google.com"]
+    }
+    condition {
+      test     = "StringEquals"
+      variable = "accounts.google.com:sub"
+      values   = [chronicle_feed_amazon_s3.s3_role.details[0].role_authentication[0].subject_id]
+    }
+  }
 }
@@ -24,3 +24,19 @@ resource "chronicle_feed_amazon_sqs" "sqs" {
   }
 
 }
+
+resource "chronicle_feed_amazon_sqs" "sqs_role" {
+  display_name = "mysqsrolefeed"
+  log_type     = "GITHUB"
+  enabled      = false
+
+  details {
+    queue                 = "sqs"
+    region                = "EU_WEST_1"
+    account_number        = "111111111111"
+    source_delete_options = "SOURCE_DELETION_NEVER"
+    role_authentication {
+      role_arn = "arn:aws:iam::111111111111:role/chronicle"
+    }
+  }
+}
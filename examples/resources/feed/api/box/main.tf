@@ -0,0 +1,13 @@
+resource "chronicle_feed_box" "feed" {
+  display_name = "box"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    authentication {
+      app_config_json = file("box_config.json")
+    }
+  }
+}
@@ -0,0 +1,14 @@
+resource "chronicle_feed_cloudflare_audit" "feed" {
+  display_name = "cloudflare"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    account_id = "0123456789abcdef0123456789abcdef"
+    authentication {
+      token = "XXXXXXXX"
+    }
+  }
+}
@@ -0,0 +1,15 @@
+resource "chronicle_feed_crowdstrike_falcon_detections" "feed" {
+  display_name = "crowdstrike detections"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    hostname = "api.eu-1.crowdstrike.com"
+    authentication {
+      client_id     = "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+      client_secret = "XXXXXXXX"
+    }
+  }
+}
@@ -0,0 +1,15 @@
+resource "chronicle_feed_duo_admin" "feed" {
+  display_name = "duo"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    hostname = "api-XXXXXXXX.duosecurity.com"
+    authentication {
+      integration_key = "XXXXXXXXXXXXXXXXXXXX"
+      secret_key      = "XXXXXXXX"
+    }
+  }
+}
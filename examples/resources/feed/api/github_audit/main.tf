@@ -0,0 +1,14 @@
+resource "chronicle_feed_github_audit" "feed" {
+  display_name = "github"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    enterprise = "example"
+    authentication {
+      token = "ghp_XXXXXXXX"
+    }
+  }
+}
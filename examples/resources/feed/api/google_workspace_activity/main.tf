@@ -0,0 +1,16 @@
+resource "chronicle_feed_google_workspace_activity" "test" {
+  display_name = "workspace activity"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    customer_id  = "C01234567"
+    applications = ["login", "admin"]
+    authentication {
+      admin_email          = "admin@example.com"
+      service_account_json = file("service-account.json")
+    }
+  }
+}
@@ -0,0 +1,15 @@
+resource "chronicle_feed_google_workspace_groups" "test" {
+  display_name = "workspace groups"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    customer_id = "C01234567"
+    authentication {
+      admin_email          = "admin@example.com"
+      service_account_json = file("service-account.json")
+    }
+  }
+}
@@ -0,0 +1,16 @@
+resource "chronicle_feed_microsoft_defender_for_endpoint" "feed" {
+  display_name = "defender"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    hostname  = "api-eu.securitycenter.microsoft.com"
+    tenant_id = "XXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXX"
+    authentication {
+      client_id     = "XXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXX"
+      client_secret = "XXXXXXXX"
+    }
+  }
+}
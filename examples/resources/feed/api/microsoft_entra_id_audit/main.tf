@@ -0,0 +1,17 @@
+resource "chronicle_feed_microsoft_entra_id_audit" "feed" {
+  display_name = "microsoft entra id audit"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    hostname      = "graph.microsoft.us/v1.0"
+    auth_endpoint = "login.microsoftonline.us"
+    tenant_id     = "XXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXX"
+    authentication {
+      client_id     = "XXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXX"
+      client_secret = "XXXXXXXX"
+    }
+  }
+}
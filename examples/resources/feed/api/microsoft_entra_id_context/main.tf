@@ -0,0 +1,17 @@
+resource "chronicle_feed_microsoft_entra_id_context" "feed" {
+  display_name = "microsoft entra id context"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    tenant_id        = "XXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXX"
+    retrieve_devices = true
+    retrieve_groups  = true
+    authentication {
+      client_id     = "XXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXX"
+      client_secret = "XXXXXXXX"
+    }
+  }
+}
@@ -0,0 +1,15 @@
+resource "chronicle_feed_microsoft_entra_id_sign_in" "feed" {
+  display_name = "microsoft entra id sign in"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    tenant_id = "XXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXX"
+    authentication {
+      client_id     = "XXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXX"
+      client_secret = "XXXXXXXX"
+    }
+  }
+}
@@ -0,0 +1,15 @@
+resource "chronicle_feed_microsoft_graph_alert" "feed" {
+  display_name = "microsoft graph alert"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    tenant_id = "XXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXX"
+    authentication {
+      client_id     = "XXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXX"
+      client_secret = "XXXXXXXX"
+    }
+  }
+}
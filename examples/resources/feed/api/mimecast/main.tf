@@ -0,0 +1,17 @@
+resource "chronicle_feed_mimecast" "feed" {
+  display_name = "mimecast"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    base_url = "eu-api.mimecast.com"
+    authentication {
+      application_id  = "XXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXX"
+      application_key = "XXXXXXXX"
+      access_key      = "XXXXXXXX"
+      secret_key      = "XXXXXXXX"
+    }
+  }
+}
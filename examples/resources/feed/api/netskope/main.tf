@@ -0,0 +1,16 @@
+resource "chronicle_feed_netskope" "feed" {
+  display_name = "netskope"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    hostname           = "example.goskope.com"
+    content_type       = "alerts"
+    content_categories = ["dlp", "malware", "policy"]
+    authentication {
+      token = "XXXXXXXX"
+    }
+  }
+}
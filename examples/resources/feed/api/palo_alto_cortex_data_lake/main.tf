@@ -0,0 +1,16 @@
+resource "chronicle_feed_palo_alto_cortex_data_lake" "feed" {
+  display_name = "cortex"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    region = "europe"
+    authentication {
+      client_id     = "XXXXXXXX"
+      client_secret = "XXXXXXXX"
+      refresh_token = "XXXXXXXX"
+    }
+  }
+}
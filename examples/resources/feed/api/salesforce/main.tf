@@ -0,0 +1,16 @@
+resource "chronicle_feed_salesforce" "feed" {
+  display_name = "salesforce"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    hostname = "example.my.salesforce.com"
+    authentication {
+      client_id   = "XXXXXXXX"
+      user        = "chronicle@example.com"
+      private_key = file("salesforce.key")
+    }
+  }
+}
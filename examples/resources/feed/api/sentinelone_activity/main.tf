@@ -0,0 +1,14 @@
+resource "chronicle_feed_sentinelone_activity" "feed" {
+  display_name = "sentinelone activity"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    hostname = "example.sentinelone.net"
+    authentication {
+      api_token = "XXXXXXXX"
+    }
+  }
+}
@@ -0,0 +1,13 @@
+resource "chronicle_feed_slack_audit" "feed" {
+  display_name = "slack"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    authentication {
+      oauth_token = "xoxp-XXXXXXXX"
+    }
+  }
+}
@@ -0,0 +1,15 @@
+resource "chronicle_feed_workday" "feed" {
+  display_name = "workday"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    hostname = "wd5-services1.myworkday.com"
+    authentication {
+      user   = "ISU_Chronicle"
+      secret = "XXXXXXXX"
+    }
+  }
+}
@@ -0,0 +1,16 @@
+resource "chronicle_feed_zscaler" "feed" {
+  display_name = "zscaler"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    cloud_name = "zscalertwo"
+    authentication {
+      api_key = "XXXXXXXXXXXX"
+      user    = "chronicle@example.com"
+      secret  = "XXXXXXXX"
+    }
+  }
+}
@@ -0,0 +1,18 @@
+resource "chronicle_feed_azure_event_hub" "event_hub" {
+  display_name = "myeventhubfeed"
+  log_type     = "AZURE_AD"
+  enabled      = true
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    name              = "entra-id-signins"
+    consumer_group    = "chronicle"
+    connection_string = "Endpoint=sb://mynamespace.servicebus.windows.net/;SharedAccessKeyName=chronicle;SharedAccessKey=XXXX"
+    checkpoint_storage {
+      connection_string = "DefaultEndpointsProtocol=https;AccountName=myaccount;AccountKey=XXXX;EndpointSuffix=core.windows.net"
+      container         = "chronicle-checkpoints"
+    }
+  }
+}
@@ -0,0 +1,18 @@
+resource "chronicle_feed" "http" {
+  display_name     = "http-feed"
+  feed_source_type = "HTTP"
+  log_type         = "GITHUB"
+  enabled          = true
+  namespace        = "test"
+  labels = {
+    "team" = "security"
+  }
+  details_json = jsonencode({
+    httpSettings = {
+      uri                  = "https://example.com/audit.log"
+      sourceType           = "FILES"
+      sourceDeletionOption = "SOURCE_DELETION_NEVER"
+    }
+  })
+  sensitive_paths = ["httpSettings.sourceDeletionOption"]
+}
@@ -0,0 +1,22 @@
+resource "chronicle_feed_google_cloud_pubsub_push" "pubsub" {
+  display_name = "mypubsubfeed"
+  log_type     = "GITHUB"
+  enabled      = true
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    split_delimiter = "\n"
+  }
+}
+
+# The feed endpoint can be consumed directly by a Pub/Sub push subscription.
+resource "google_pubsub_subscription" "chronicle" {
+  name  = "chronicle-github"
+  topic = "github-logs"
+
+  push_config {
+    push_endpoint = chronicle_feed_google_cloud_pubsub_push.pubsub.endpoint_url
+  }
+}
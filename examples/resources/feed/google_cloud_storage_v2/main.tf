@@ -0,0 +1,26 @@
+data "chronicle_feed_service_account" "chronicle" {}
+
+resource "google_storage_bucket_iam_member" "chronicle" {
+  bucket = "my-bucket"
+  role   = "roles/storage.objectViewer"
+  member = data.chronicle_feed_service_account.chronicle.member
+}
+
+resource "chronicle_feed_google_cloud_storage_v2" "bucket" {
+  display_name = "mygcsv2feed"
+  log_type     = "GITHUB"
+  enabled      = true
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    bucket_uri            = "gs://my-bucket/"
+    source_delete_options = "SOURCE_DELETION_NEVER"
+    max_file_age_days     = 30
+    include_prefixes      = ["github/"]
+    exclude_prefixes      = ["github/archive/"]
+  }
+
+  depends_on = [google_storage_bucket_iam_member.chronicle]
+}
@@ -0,0 +1,18 @@
+resource "chronicle_feed_http" "http" {
+  display_name = "myhttpfeed"
+  log_type     = "GITHUB"
+  enabled      = false
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    uri                   = "https://example.com/logs/github.json"
+    source_type           = "FILES"
+    source_delete_options = "SOURCE_DELETION_NEVER"
+    authentication {
+      key   = "Authorization"
+      value = "Bearer XXXX"
+    }
+  }
+}
@@ -0,0 +1,16 @@
+resource "chronicle_feed_webhook" "webhook" {
+  display_name = "mywebhookfeed"
+  log_type     = "GITHUB"
+  enabled      = true
+  namespace    = "one"
+  labels = {
+    "env" = "one"
+  }
+  details {
+    split_delimiter = "\n"
+  }
+}
+
+output "webhook_endpoint_url" {
+  value = chronicle_feed_webhook.webhook.endpoint_url
+}
@@ -0,0 +1,32 @@
+resource "chronicle_forwarder_collector" "syslog" {
+  forwarder_id          = chronicle_forwarder.forwarder.id
+  display_name          = "firewall"
+  log_type              = "PAN_FIREWALL"
+  max_seconds_per_batch = 10
+  max_bytes_per_batch   = 1048576
+  disk_buffer {
+    directory_path        = "/var/lib/chronicle"
+    max_file_buffer_bytes = 1073741824
+  }
+  syslog_settings {
+    protocol            = "TCP"
+    address             = "0.0.0.0"
+    port                = 10514
+    certificate         = "/opt/chronicle/external/certs/client.crt"
+    certificate_key     = "/opt/chronicle/external/certs/client.key"
+    minimum_tls_version = "TLSv1_2"
+  }
+}
+
+resource "chronicle_forwarder_collector" "splunk" {
+  forwarder_id = chronicle_forwarder.forwarder.id
+  display_name = "splunk-dns"
+  log_type     = "WINDOWS_DNS"
+  splunk_settings {
+    host         = "splunk.example.com"
+    port         = 8089
+    username     = "chronicle"
+    password     = "password"
+    query_string = "search index=dns"
+  }
+}
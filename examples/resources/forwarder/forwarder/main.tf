@@ -0,0 +1,21 @@
+resource "chronicle_forwarder" "forwarder" {
+  display_name       = "datacenter-1"
+  upload_compression = true
+  namespace          = "datacenter-1"
+  labels = {
+    "site" = "dc1"
+  }
+  regex_filter {
+    description = "drop debug lines"
+    regexp      = ".*DEBUG.*"
+    behavior    = "BLOCK"
+  }
+  server_settings {
+    graceful_timeout = 15
+    drain_timeout    = 10
+    http_settings {
+      port = 8080
+      host = "0.0.0.0"
+    }
+  }
+}
@@ -1,14 +1,20 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
 
 	"github.com/form3tech-oss/terraform-provider-chronicle/chronicle"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 )
 
-// Generate the Terraform provider documentation using `tfplugindocs`:
+// Generate the templates of the feed resources generated from the feed type registry, then the Terraform provider
+// documentation using `tfplugindocs`:
 //
+//go:generate go run ./tools/feedtemplates
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
 func main() {
 	var debug bool
@@ -16,8 +22,24 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	plugin.Serve(&plugin.ServeOpts{
-		Debug:        debug,
-		ProviderFunc: chronicle.Provider,
-	})
+	ctx := context.Background()
+
+	// The SDKv2 provider is served first, so that it is configured before the plugin framework provider sharing its client.
+	sdkProvider := chronicle.Provider()
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(chronicle.NewFrameworkProvider(sdkProvider)),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf5server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
+	}
+
+	if err := tf5server.Serve("github.com/form3tech-oss/chronicle", muxServer.ProviderServer, serveOpts...); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -0,0 +1,14 @@
+// Command feedtemplates writes the documentation templates of the feed resources generated from the feed type registry.
+package main
+
+import (
+	"log"
+
+	"github.com/form3tech-oss/terraform-provider-chronicle/chronicle"
+)
+
+func main() {
+	if err := chronicle.WriteFeedResourceTemplates("templates/resources"); err != nil {
+		log.Fatal(err)
+	}
+}